@@ -0,0 +1,230 @@
+package data
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ECBHistory90dURL is the ECB feed of the reference rates of the last 90 days
+	ECBHistory90dURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml"
+	// ECBHistoryURL is the ECB feed of every reference rate since 1999
+	ECBHistoryURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml"
+)
+
+// DateLayout is the layout of the dates used by the historical rates
+const DateLayout = "2006-01-02"
+
+// maxFixingGap is how far back a date without a fixing looks for the
+// previous business day, long enough for the Easter and Christmas closures
+const maxFixingGap = 7 * 24 * time.Hour
+
+// ErrNoFixing is returned when there is no fixing on or shortly before a date
+var ErrNoFixing = fmt.Errorf("no fixing for date")
+
+// DatedRate is the rate of a single fixing
+type DatedRate struct {
+	Date time.Time
+	Rate float64
+}
+
+// HistoryStore keeps the reference rates of past days by the date they were
+// fixed on, like the daily rates they are the amount of each currency one
+// euro buys
+type HistoryStore struct {
+	mu    sync.RWMutex
+	days  map[time.Time]map[string]float64
+	dates []time.Time
+}
+
+// NewHistoryStore returns an empty store
+func NewHistoryStore() *HistoryStore {
+	return &HistoryStore{days: map[time.Time]map[string]float64{}}
+}
+
+// Add stores the rates fixed on date, replacing those already known for it
+func (h *HistoryStore) Add(date time.Time, rates map[string]float64) {
+	date = truncateDay(date)
+
+	day := make(map[string]float64, len(rates)+1)
+	for k, v := range rates {
+		day[k] = v
+	}
+	day["EUR"] = 1
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.days[date]; !ok {
+		i := sort.Search(len(h.dates), func(i int) bool { return !h.dates[i].Before(date) })
+		h.dates = append(h.dates, time.Time{})
+		copy(h.dates[i+1:], h.dates[i:])
+		h.dates[i] = date
+	}
+	h.days[date] = day
+}
+
+// Len returns the number of days in the store
+func (h *HistoryStore) Len() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.dates)
+}
+
+// Rate returns the rate from base to dest fixed on date. Weekends and
+// holidays have no fixing, for them the rate of the previous business day
+// is returned. The date of the fixing used is returned with the rate
+func (h *HistoryStore) Rate(base, dest string, date time.Time) (float64, time.Time, error) {
+	date = truncateDay(date)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	// the last fixing on or before date
+	i := sort.Search(len(h.dates), func(i int) bool { return h.dates[i].After(date) }) - 1
+	if i < 0 || date.Sub(h.dates[i]) > maxFixingGap {
+		return 0, time.Time{}, fmt.Errorf("%w %s", ErrNoFixing, date.Format(DateLayout))
+	}
+
+	fixed := h.dates[i]
+	r, err := crossRate(h.days[fixed], base, dest)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("%w on %s", err, fixed.Format(DateLayout))
+	}
+
+	return r, fixed, nil
+}
+
+// Series returns the rate from base to dest of every fixing from from to to,
+// both included, oldest first. Fixings which do not have both currencies
+// are left out
+func (h *HistoryStore) Series(base, dest string, from, to time.Time) ([]DatedRate, error) {
+	from, to = truncateDay(from), truncateDay(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("from %s is after to %s", from.Format(DateLayout), to.Format(DateLayout))
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	i := sort.Search(len(h.dates), func(i int) bool { return !h.dates[i].Before(from) })
+
+	var series []DatedRate
+	for ; i < len(h.dates) && !h.dates[i].After(to); i++ {
+		r, err := crossRate(h.days[h.dates[i]], base, dest)
+		if err != nil {
+			continue
+		}
+		series = append(series, DatedRate{h.dates[i], r})
+	}
+
+	return series, nil
+}
+
+// crossRate returns the rate from base to dest given the euro rates of a day
+func crossRate(day map[string]float64, base, dest string) (float64, error) {
+	br, ok := day[base]
+	if !ok {
+		return 0, fmt.Errorf("%w %s", ErrUnknownCurrency, base)
+	}
+	dr, ok := day[dest]
+	if !ok {
+		return 0, fmt.Errorf("%w %s", ErrUnknownCurrency, dest)
+	}
+
+	return br / dr, nil
+}
+
+// Load adds every day of an ECB history XML document, such as the 90 day or
+// the full history feeds
+func (h *HistoryStore) Load(r io.Reader) error {
+	days, err := decodeECBHistory(r)
+	if err != nil {
+		return err
+	}
+
+	for d, rates := range days {
+		h.Add(d, rates)
+	}
+
+	return nil
+}
+
+// LoadLocation loads the ECB history XML document at location, an http or
+// https URL or the path of a local file
+func (h *HistoryStore) LoadLocation(location string) error {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		resp, err := ecbClient.Get(location)
+		if err != nil {
+			return fmt.Errorf("unable to get rate history from %s: %w", location, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("expected status code 200 from %s got %d", location, resp.StatusCode)
+		}
+		return h.Load(resp.Body)
+	}
+
+	f, err := os.Open(location)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := h.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", location, err)
+	}
+	return nil
+}
+
+// historyCubes is the layout of the ECB history feeds, one dated Cube per day
+type historyCubes struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []Cube `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+func decodeECBHistory(r io.Reader) (map[time.Time]map[string]float64, error) {
+	hc := &historyCubes{}
+	if err := xml.NewDecoder(r).Decode(hc); err != nil {
+		return nil, fmt.Errorf("unable to decode ECB rate history: %w", err)
+	}
+	if len(hc.Days) == 0 {
+		return nil, fmt.Errorf("no days in ECB rate history")
+	}
+
+	days := make(map[time.Time]map[string]float64, len(hc.Days))
+	for _, d := range hc.Days {
+		date, err := time.Parse(DateLayout, d.Time)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date in ECB rate history: %w", err)
+		}
+
+		rates := make(map[string]float64, len(d.Rates))
+		for _, c := range d.Rates {
+			r, err := parseRate(c.Currency, c.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", d.Time, err)
+			}
+			rates[c.Currency] = r
+		}
+		days[date] = rates
+	}
+
+	return days, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
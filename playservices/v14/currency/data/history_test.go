@@ -0,0 +1,52 @@
+package data
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	d, _ := time.Parse(DateLayout, s)
+	return d
+}
+
+func TestHistoryStore(t *testing.T) {
+	h := NewHistoryStore()
+	if err := h.LoadLocation("testdata/eurofxref-hist-90d.xml"); err != nil {
+		t.Fatal(err)
+	}
+	if h.Len() != 4 {
+		t.Fatalf("expected 4 days, got %d", h.Len())
+	}
+
+	r, fixed, err := h.Rate("USD", "EUR", day("2024-07-11"))
+	if err != nil || r != 1.0845 || !fixed.Equal(day("2024-07-11")) {
+		t.Fatalf("expected the fixing of the day, got %v %v %v", r, fixed, err)
+	}
+
+	// Sunday falls back to Friday
+	r, fixed, err = h.Rate("USD", "EUR", day("2024-07-14"))
+	if err != nil || r != 1.0890 || !fixed.Equal(day("2024-07-12")) {
+		t.Fatalf("expected the fixing of the previous Friday, got %v %v %v", r, fixed, err)
+	}
+
+	for _, d := range []string{"2024-07-09", "2024-08-01"} {
+		if _, _, err := h.Rate("USD", "EUR", day(d)); !errors.Is(err, ErrNoFixing) {
+			t.Errorf("expected no fixing for %s, got %v", d, err)
+		}
+	}
+
+	s, err := h.Series("USD", "GBP", day("2024-07-11"), day("2024-07-14"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	usd, gbp := 1.0890, 0.84125
+	if len(s) != 2 || !s[0].Date.Equal(day("2024-07-11")) || s[1].Rate != usd/gbp {
+		t.Fatalf("unexpected series %v", s)
+	}
+
+	if _, err := h.Series("USD", "GBP", day("2024-07-14"), day("2024-07-11")); err == nil {
+		t.Fatal("expected an error when from is after to")
+	}
+}
@@ -1,11 +1,8 @@
 package data
 
 import (
-	"encoding/xml"
 	"fmt"
-	"math/rand"
-	"net/http"
-	"strconv"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -20,100 +17,235 @@ type Cube struct {
 	Rate     string `xml:"rate,attr"`
 }
 
+// ErrUnknownCurrency is returned for a currency which has no rate
+var ErrUnknownCurrency = fmt.Errorf("rate not found for currency")
+
+// Snapshot is a version of the rates, it is never modified once published
+// so it can be read without locking
+type Snapshot struct {
+	Version uint64
+	// Rates is the amount of each currency one euro buys
+	Rates map[string]float64
+	// Updated is when the rate of each currency last changed
+	Updated map[string]time.Time
+}
+
+// Rate returns the rate from base to dest
+func (s *Snapshot) Rate(base, dest string) (float64, error) {
+	return crossRate(s.Rates, base, dest)
+}
+
+// RateChange is the move of a single currency between two versions, Old is
+// 0 for a currency which was not listed in the earlier version
+type RateChange struct {
+	Currency string
+	Old      float64
+	New      float64
+}
+
+// Delta returns the relative change, 0.01 for a 1% rise
+func (rc RateChange) Delta() float64 {
+	return rc.New/rc.Old - 1
+}
+
+// RatesUpdate lists the currencies which moved between two versions of the
+// rates. Updates a listener has not received yet are merged into one, so
+// From is the version the listener last saw
+type RatesUpdate struct {
+	From    uint64
+	To      uint64
+	Changes map[string]RateChange
+}
+
+// merge returns the update from the start of u to the end of next
+func (u RatesUpdate) merge(next RatesUpdate) RatesUpdate {
+	m := RatesUpdate{From: u.From, To: next.To, Changes: make(map[string]RateChange, len(u.Changes))}
+	for k, v := range u.Changes {
+		m.Changes[k] = v
+	}
+	for k, v := range next.Changes {
+		if prev, ok := m.Changes[k]; ok {
+			v.Old = prev.Old
+		}
+		m.Changes[k] = v
+	}
+	return m
+}
+
 type ExchangeRatesHandler struct {
-	l     *zap.Logger
-	rates map[string]float64
+	l    *zap.Logger
+	src  RateSource
+	snap atomic.Pointer[Snapshot]
+	// monitoring is set while the MonitorRates goroutine runs
+	monitoring atomic.Bool
+	// refreshed is when the rates were last loaded from src, in unix nanoseconds
+	refreshed atomic.Int64
 }
 
-func GetExchangeRatesHandler(log *zap.Logger) (*ExchangeRatesHandler, error) {
+// GetExchangeRatesHandler returns a handler loaded with the rates from src
+func GetExchangeRatesHandler(src RateSource, log *zap.Logger) (*ExchangeRatesHandler, error) {
 	e := &ExchangeRatesHandler{
-		l:     log,
-		rates: map[string]float64{},
+		l:   log,
+		src: src,
 	}
+	e.snap.Store(&Snapshot{Rates: map[string]float64{}})
 	err := e.getRates()
 
 	return e, err
 }
 
-func (e ExchangeRatesHandler) GetRates(base, dest string) (float64, error) {
-	br, ok := e.rates[base]
-	if !ok {
-		return 0, fmt.Errorf("rate not found for currency %s", base)
-	}
+// Snapshot returns the current rates, the map must not be modified
+func (e *ExchangeRatesHandler) Snapshot() *Snapshot {
+	return e.snap.Load()
+}
+
+// Loaded reports whether the rates have been loaded from the source
+func (e *ExchangeRatesHandler) Loaded() bool {
+	return e.snap.Load().Version > 0
+}
 
-	dr, ok := e.rates[dest]
-	if !ok {
-		return 0, fmt.Errorf("rate not found for currency %s", dest)
+// Refreshed returns when the rates were last loaded from the source, it is
+// zero until they have been
+func (e *ExchangeRatesHandler) Refreshed() time.Time {
+	if n := e.refreshed.Load(); n != 0 {
+		return time.Unix(0, n)
 	}
+	return time.Time{}
+}
+
+// Monitoring reports whether MonitorRates is moving the rates
+func (e *ExchangeRatesHandler) Monitoring() bool {
+	return e.monitoring.Load()
+}
 
-	return br / dr, nil
+// GetRates returns the current rate from base to dest, ErrUnknownCurrency
+// when either has no rate
+func (e *ExchangeRatesHandler) GetRates(base, dest string) (float64, error) {
+	return e.snap.Load().Rate(base, dest)
 }
 
-func (e ExchangeRatesHandler) getRates() error {
-	resp, err := http.DefaultClient.Get("https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml")
+func (e *ExchangeRatesHandler) getRates() error {
+	rates, err := e.src.Rates()
 	if err != nil {
-		e.l.Error("error attempting GET to URL", zap.Error(err))
+		e.l.Error("unable to load rates", zap.Error(err))
 		return err
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("expected status code 200 got %d", resp.StatusCode)
-	}
-	defer resp.Body.Close()
-	md := &Cubes{}
-	xml.NewDecoder(resp.Body).Decode(&md)
-
-	for _, v := range md.CubeData {
-		r, err := strconv.ParseFloat(v.Rate, 64)
-		if err != nil {
-			e.l.Error("error parsing float", zap.Error(err))
-			return err
+
+	rates["EUR"] = 1
+	e.publish(rates)
+	e.refreshed.Store(time.Now().UnixNano())
+
+	return nil
+}
+
+// publish swaps in rates as the next version and returns what changed,
+// rates equal to the current ones do not make a new version
+func (e *ExchangeRatesHandler) publish(rates map[string]float64) RatesUpdate {
+	old := e.snap.Load()
+	next := &Snapshot{Version: old.Version + 1, Rates: rates, Updated: make(map[string]time.Time, len(rates))}
+
+	now := time.Now()
+	u := RatesUpdate{From: old.Version, To: next.Version, Changes: map[string]RateChange{}}
+	same := len(rates) == len(old.Rates)
+	for k, v := range rates {
+		next.Updated[k] = now
+
+		o, ok := old.Rates[k]
+		if !ok {
+			u.Changes[k] = RateChange{k, 0, v}
+			same = false
+			continue
+		}
+		if o != v {
+			u.Changes[k] = RateChange{k, o, v}
+			continue
 		}
-		e.rates[v.Currency] = r
+		next.Updated[k] = old.Updated[k]
+	}
+	if same && len(u.Changes) == 0 {
+		return RatesUpdate{From: old.Version, To: old.Version}
 	}
 
-	e.rates["EUR"] = 1
+	e.snap.Store(next)
 
-	return nil
+	return u
 }
 
-// MonitorRates checks the rates in the ECB API every interval and sends a message to the
-// returned channel when there are changes
+// MonitorRates moves the rates every interval of cfg, reloads the fixings
+// from the source every refresh of cfg and sends what changed to the
+// returned channel. Sending never blocks, when the previous update has not
+// been received yet it is merged with the new one
 //
-// Note: the ECB API only returns data once a day, this function only simulates the changes
-// in rates for demonstration purposes
-func (e *ExchangeRatesHandler) MonitorRates(interval time.Duration) chan struct{} {
-	ret := make(chan struct{})
+// Note: the ECB API only returns data once a day, the walk and ou models only simulate
+// the changes in rates for demonstration purposes around the latest fixings, none only
+// publishes the fixings. Rates which could not be loaded at start are loaded again every interval
+func (e *ExchangeRatesHandler) MonitorRates(cfg SimulationConfig) <-chan RatesUpdate {
+	ret := make(chan RatesUpdate, 1)
+
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	e.l.Info("monitoring rates", zap.String("model", string(cfg.Model)), zap.Duration("interval", cfg.Interval), zap.Duration("refresh", cfg.Refresh), zap.Int64("seed", cfg.Seed))
 
+	e.monitoring.Store(true)
 	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
+		defer e.monitoring.Store(false)
 
-		for range ticker.C {
-			// just add a random difference to the rate and return it
-			// this simulates the fluctuations in currency rates
-			for k, v := range e.rates {
-				// change can be 10% of original value
-				change := (rand.Float64() / 10)
-
-				// random direction: 0 or 1
-				direction := rand.Intn(2)
-
-				if direction == 0 {
-					// new value will be at least 90% of the old
-					change = 1 - change
-				} else {
-					// new value will be at most 110% of the old
-					change = 1 + change
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		refresh := time.NewTicker(cfg.Refresh)
+		defer refresh.Stop()
+
+		sim := newSimulator(cfg)
+		fixing := e.snap.Load().Rates
+
+		for {
+			var rates map[string]float64
+			select {
+			case <-refresh.C:
+			case <-ticker.C:
+				if e.Loaded() {
+					if cfg.Model == SimulateNone {
+						continue
+					}
+					rates = sim.step(fixing, e.snap.Load().Rates)
 				}
+			}
 
-				// modify the rate
-				e.rates[k] = v * change
+			// every model starts again from the new fixings
+			if rates == nil {
+				r, err := e.src.Rates()
+				if err != nil {
+					e.l.Error("unable to reload rates", zap.Error(err))
+					continue
+				}
+				r["EUR"] = 1
+				rates, fixing = r, r
+				e.refreshed.Store(time.Now().UnixNano())
 			}
 
-			// notify updates, this will block unless there is a listener on the other end
-			ret <- struct{}{}
+			if u := e.publish(rates); len(u.Changes) > 0 {
+				notify(ret, u)
+			}
 		}
 	}()
 
 	return ret
 }
+
+// notify sends u without blocking, this goroutine is the only sender so
+// after taking back an unread update there is room for the merged one
+func notify(ch chan RatesUpdate, u RatesUpdate) {
+	select {
+	case ch <- u:
+		return
+	default:
+	}
+
+	select {
+	case prev := <-ch:
+		u = prev.merge(u)
+	default:
+	}
+	ch <- u
+}
@@ -1,16 +1,161 @@
 package data
 
 import (
+	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 )
 
 func TestNewRates(t *testing.T) {
 	l, _ := zap.NewProduction()
-	tr, err := GetExchangeRatesHandler(l)
+	tr, err := GetExchangeRatesHandler(&FileSource{"testdata/eurofxref-daily.xml"}, l)
 	if err != nil {
 		t.Fatal(err)
 	}
-	tr.l.Info("[INFO]", zap.Any("tr", tr.rates))
+	tr.l.Info("[INFO]", zap.Any("tr", tr.Snapshot().Rates))
+
+	r, err := tr.GetRates("USD", "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != 1.089 {
+		t.Fatalf("expected USD to EUR at 1.089, got %v", r)
+	}
+}
+
+func TestRatesConcurrentReaders(t *testing.T) {
+	tr, err := GetExchangeRatesHandler(StaticSource{"USD": 1.1, "GBP": 0.85}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := DefaultSimulation
+	cfg.Interval = time.Millisecond
+	updates := tr.MonitorRates(cfg)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if _, err := tr.GetRates("USD", "GBP"); err != nil {
+					t.Error(err)
+					return
+				}
+				s := tr.Snapshot()
+				_ = s.Rates["USD"] / s.Rates["GBP"]
+			}
+		}()
+	}
+	wg.Wait()
+
+	u := <-updates
+	if u.To <= u.From || len(u.Changes) == 0 {
+		t.Fatalf("expected an update with changes, got %+v", u)
+	}
+}
+
+func TestNotifyMergesUnreadUpdates(t *testing.T) {
+	ch := make(chan RatesUpdate, 1)
+
+	notify(ch, RatesUpdate{1, 2, map[string]RateChange{"USD": {"USD", 1.0, 1.1}}})
+	notify(ch, RatesUpdate{2, 3, map[string]RateChange{"USD": {"USD", 1.1, 1.2}, "GBP": {"GBP", 0.8, 0.9}}})
+
+	u := <-ch
+	if u.From != 1 || u.To != 3 {
+		t.Fatalf("expected the update from 1 to 3, got %d to %d", u.From, u.To)
+	}
+	if c := u.Changes["USD"]; c.Old != 1.0 || c.New != 1.2 {
+		t.Fatalf("expected USD to move from 1.0 to 1.2, got %+v", c)
+	}
+	if len(u.Changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", u.Changes)
+	}
+}
+
+func TestPublishTracksUpdated(t *testing.T) {
+	tr, err := GetExchangeRatesHandler(StaticSource{"USD": 1.1, "GBP": 0.85}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded := tr.Snapshot().Updated
+
+	time.Sleep(time.Millisecond)
+	tr.publish(map[string]float64{"EUR": 1, "USD": 1.2, "GBP": 0.85})
+
+	s := tr.Snapshot()
+	if !s.Updated["GBP"].Equal(loaded["GBP"]) {
+		t.Fatalf("expected GBP to keep its update time %v, got %v", loaded["GBP"], s.Updated["GBP"])
+	}
+	if !s.Updated["USD"].After(loaded["USD"]) {
+		t.Fatalf("expected USD to be updated after %v, got %v", loaded["USD"], s.Updated["USD"])
+	}
+
+	if _, err := tr.GetRates("USD", "XXX"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Fatalf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+// countingSource is a RateSource whose USD rate is the number of loads
+type countingSource struct {
+	calls atomic.Int32
+}
+
+func (c *countingSource) Rates() (map[string]float64, error) {
+	return map[string]float64{"USD": float64(c.calls.Add(1))}, nil
+}
+
+func TestMonitorRatesRefreshesFixings(t *testing.T) {
+	for _, m := range []SimulationModel{SimulateNone, SimulateRandomWalk} {
+		src := &countingSource{}
+		tr, err := GetExchangeRatesHandler(src, zap.NewNop())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cfg := DefaultSimulation
+		cfg.Model = m
+		cfg.Interval = time.Millisecond
+		cfg.Refresh = 50 * time.Millisecond
+		updates := tr.MonitorRates(cfg)
+
+		deadline := time.After(5 * time.Second)
+		// the walk stays within 10% of the fixing, so it can only pass 1.5
+		// once the second fixing is loaded
+		for tr.Snapshot().Rates["USD"] < 1.5 {
+			select {
+			case <-updates:
+			case <-deadline:
+				t.Fatalf("%s: expected the fixings to be reloaded", m)
+			}
+		}
+
+		// the simulation ticks do not load the source
+		if n := src.calls.Load(); n > 3 {
+			t.Fatalf("%s: expected the source to be loaded on refresh only, got %d loads", m, n)
+		}
+	}
+}
+
+func TestPublishListsNewCurrencies(t *testing.T) {
+	tr, err := GetExchangeRatesHandler(StaticSource{"USD": 1.1}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := tr.publish(map[string]float64{"EUR": 1, "USD": 1.1, "GBP": 0.85})
+	if u.To == u.From {
+		t.Fatal("expected a new version")
+	}
+	if c, ok := u.Changes["GBP"]; !ok || c.Old != 0 || c.New != 0.85 {
+		t.Fatalf("expected GBP to be listed as a change from 0, got %+v", u.Changes)
+	}
+	if len(u.Changes) != 1 {
+		t.Fatalf("expected only GBP to change, got %+v", u.Changes)
+	}
 }
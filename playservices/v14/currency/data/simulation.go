@@ -0,0 +1,173 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SimulationModel is how MonitorRates moves the rates between fixings
+type SimulationModel string
+
+const (
+	// SimulateNone only publishes the fixings reloaded from the source
+	SimulateNone SimulationModel = "none"
+	// SimulateRandomWalk moves every rate by a random step, keeping it
+	// within Bound of its fixing
+	SimulateRandomWalk SimulationModel = "walk"
+	// SimulateOU is a mean-reverting Ornstein–Uhlenbeck process on the log
+	// of every rate, pulled back towards its fixing by Reversion every tick
+	SimulateOU SimulationModel = "ou"
+)
+
+// SimulationConfig configures MonitorRates. Runs with the same seed and
+// fixings produce the same sequence of rates
+type SimulationConfig struct {
+	Model SimulationModel
+	// Interval is how often the simulated rates move
+	Interval time.Duration
+	// Refresh is how often the fixings are reloaded from the source, the
+	// ECB publishes them once a day
+	Refresh time.Duration
+	// Seed of the random steps, 0 picks one from the clock
+	Seed int64
+	// Volatility is the standard deviation of the relative step of a tick,
+	// 0.01 is 1%, for the currencies not in Volatilities
+	Volatility float64
+	// Volatilities overrides Volatility for single currencies
+	Volatilities map[string]float64
+	// Bound is how far the random walk can move away from the fixing, 0.1 is 10%
+	Bound float64
+	// Reversion is the fraction of the distance to the fixing the OU model
+	// closes every tick
+	Reversion float64
+}
+
+// DefaultSimulation moves the rates every 3 seconds for demonstration purposes
+var DefaultSimulation = SimulationConfig{
+	Model:      SimulateRandomWalk,
+	Interval:   3 * time.Second,
+	Refresh:    24 * time.Hour,
+	Volatility: 0.01,
+	Bound:      0.1,
+	Reversion:  0.1,
+}
+
+// ParseSimulationConfig returns DefaultSimulation changed by the settings
+// which are not empty. volatility is a default followed by CODE=volatility
+// overrides, such as 0.01,JPY=0.02
+func ParseSimulationConfig(model, interval, refresh, seed, volatility string) (SimulationConfig, error) {
+	cfg := DefaultSimulation
+
+	switch m := SimulationModel(model); m {
+	case "":
+	case SimulateNone, SimulateRandomWalk, SimulateOU:
+		cfg.Model = m
+	default:
+		return cfg, fmt.Errorf("unknown simulation model %q, expected none, walk or ou", model)
+	}
+
+	if interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid simulation interval %q", interval)
+		}
+		cfg.Interval = d
+	}
+
+	if refresh != "" {
+		d, err := time.ParseDuration(refresh)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid rate refresh interval %q", refresh)
+		}
+		cfg.Refresh = d
+	}
+
+	if seed != "" {
+		s, err := strconv.ParseInt(seed, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid simulation seed %q", seed)
+		}
+		cfg.Seed = s
+	}
+
+	for _, v := range strings.Split(volatility, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		code, vol, ok := strings.Cut(v, "=")
+		if !ok {
+			code, vol = "", v
+		}
+		f, err := strconv.ParseFloat(vol, 64)
+		if err != nil || f < 0 {
+			return cfg, fmt.Errorf("invalid simulation volatility %q", v)
+		}
+		if code == "" {
+			cfg.Volatility = f
+			continue
+		}
+		if cfg.Volatilities == nil {
+			cfg.Volatilities = map[string]float64{}
+		}
+		cfg.Volatilities[code] = f
+	}
+
+	return cfg, nil
+}
+
+func (cfg SimulationConfig) volatility(currency string) float64 {
+	if v, ok := cfg.Volatilities[currency]; ok {
+		return v
+	}
+	return cfg.Volatility
+}
+
+// simulator moves the rates one tick at a time
+type simulator struct {
+	cfg SimulationConfig
+	rnd *rand.Rand
+}
+
+func newSimulator(cfg SimulationConfig) *simulator {
+	return &simulator{cfg, rand.New(rand.NewSource(cfg.Seed))}
+}
+
+// step returns the rates after a tick from cur, fixing holds the rates the
+// models are bound to or revert to. The currencies are visited in order so
+// that a seed always gives the same rates
+func (s *simulator) step(fixing, cur map[string]float64) map[string]float64 {
+	codes := make([]string, 0, len(cur))
+	for k := range cur {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+
+	next := make(map[string]float64, len(cur))
+	for _, k := range codes {
+		r, f := cur[k], fixing[k]
+		// the rates are against the euro, it does not move
+		if k == "EUR" || f == 0 {
+			next[k] = r
+			continue
+		}
+
+		z := s.rnd.NormFloat64() * s.cfg.volatility(k)
+		switch s.cfg.Model {
+		case SimulateRandomWalk:
+			r *= 1 + z
+			r = math.Max(f*(1-s.cfg.Bound), math.Min(f*(1+s.cfg.Bound), r))
+		case SimulateOU:
+			x := math.Log(r / f)
+			x += -s.cfg.Reversion*x + z
+			r = f * math.Exp(x)
+		}
+		next[k] = r
+	}
+
+	return next
+}
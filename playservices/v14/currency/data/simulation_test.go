@@ -0,0 +1,92 @@
+package data
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var simFixing = map[string]float64{"EUR": 1, "USD": 1.089, "JPY": 172.02, "GBP": 0.84125}
+
+func simulate(cfg SimulationConfig, ticks int) []map[string]float64 {
+	s := newSimulator(cfg)
+	cur := simFixing
+	var seq []map[string]float64
+	for i := 0; i < ticks; i++ {
+		cur = s.step(simFixing, cur)
+		seq = append(seq, cur)
+	}
+	return seq
+}
+
+func TestSimulationSeeded(t *testing.T) {
+	for _, m := range []SimulationModel{SimulateRandomWalk, SimulateOU} {
+		cfg := DefaultSimulation
+		cfg.Model = m
+		cfg.Seed = 42
+
+		a, b := simulate(cfg, 50), simulate(cfg, 50)
+		if !reflect.DeepEqual(a, b) {
+			t.Fatalf("%s: expected the same rates for the same seed", m)
+		}
+
+		cfg.Seed = 43
+		if reflect.DeepEqual(a, simulate(cfg, 50)) {
+			t.Fatalf("%s: expected other rates for another seed", m)
+		}
+
+		for _, r := range a {
+			if r["EUR"] != 1 {
+				t.Fatalf("%s: expected EUR to stay at 1, got %v", m, r["EUR"])
+			}
+		}
+	}
+}
+
+func TestSimulationRandomWalkBounded(t *testing.T) {
+	cfg := DefaultSimulation
+	cfg.Seed = 1
+	cfg.Volatility = 0.05
+	cfg.Volatilities = map[string]float64{"JPY": 0}
+
+	for _, r := range simulate(cfg, 1000) {
+		for k, f := range simFixing {
+			if math.Abs(r[k]/f-1) > cfg.Bound+1e-9 {
+				t.Fatalf("expected %s to stay within %v of %v, got %v", k, cfg.Bound, f, r[k])
+			}
+		}
+		if r["JPY"] != simFixing["JPY"] {
+			t.Fatalf("expected JPY with no volatility not to move, got %v", r["JPY"])
+		}
+	}
+}
+
+func TestSimulationOUReverts(t *testing.T) {
+	cfg := DefaultSimulation
+	cfg.Model = SimulateOU
+	cfg.Volatility = 0
+	cfg.Reversion = 0.5
+
+	s := newSimulator(cfg)
+	r := s.step(simFixing, map[string]float64{"USD": 2 * simFixing["USD"]})
+	if r["USD"] >= 2*simFixing["USD"] || r["USD"] <= simFixing["USD"] {
+		t.Fatalf("expected USD to move back towards its fixing, got %v", r["USD"])
+	}
+}
+
+func TestParseSimulationConfig(t *testing.T) {
+	cfg, err := ParseSimulationConfig("ou", "1s", "12h", "7", "0.02,JPY=0.03")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Model != SimulateOU || cfg.Interval != time.Second || cfg.Refresh != 12*time.Hour || cfg.Seed != 7 || cfg.volatility("USD") != 0.02 || cfg.volatility("JPY") != 0.03 {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+
+	for _, c := range [][5]string{{"brownian", "", "", "", ""}, {"", "soon", "", "", ""}, {"", "", "0s", "", ""}, {"", "", "", "x", ""}, {"", "", "", "", "JPY=-1"}} {
+		if _, err := ParseSimulationConfig(c[0], c[1], c[2], c[3], c[4]); err == nil {
+			t.Errorf("expected an error for %v", c)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package data
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ECBDailyURL is the ECB feed of today's euro reference rates
+const ECBDailyURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+// RateSource provides reference rates, as the amount of each currency one
+// euro buys
+type RateSource interface {
+	Rates() (map[string]float64, error)
+}
+
+// GetRateSource returns the source of the kind, ecb, file or static.
+// location is the URL for ecb, defaulting to ECBDailyURL, the path of an
+// XML or JSON fixture for file and comma separated CODE=rate pairs for
+// static, defaulting to DefaultStaticRates
+func GetRateSource(kind, location string) (RateSource, error) {
+	switch kind {
+	case "", "ecb":
+		if location == "" {
+			location = ECBDailyURL
+		}
+		return &ECBSource{URL: location}, nil
+	case "file":
+		if location == "" {
+			return nil, fmt.Errorf("the file rate source needs the path of a fixture")
+		}
+		return &FileSource{Path: location}, nil
+	case "static":
+		if location == "" {
+			return DefaultStaticRates, nil
+		}
+		return ParseStaticRates(location)
+	default:
+		return nil, fmt.Errorf("unknown rate source %q, expected ecb, file or static", kind)
+	}
+}
+
+// ECBSource reads the rates from an ECB eurofxref XML document served at URL
+type ECBSource struct {
+	URL string
+	// Client defaults to a client with a 10 second timeout
+	Client *http.Client
+}
+
+var ecbClient = &http.Client{Timeout: 10 * time.Second}
+
+func (s *ECBSource) Rates() (map[string]float64, error) {
+	c := s.Client
+	if c == nil {
+		c = ecbClient
+	}
+
+	resp, err := c.Get(s.URL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rates from %s: %w", s.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected status code 200 from %s got %d", s.URL, resp.StatusCode)
+	}
+
+	return decodeECB(resp.Body)
+}
+
+// FileSource reads the rates from a local file, an ECB eurofxref XML
+// document or, for a .json file, an object like {"rates": {"USD": 1.09}}
+type FileSource struct {
+	Path string
+}
+
+func (s *FileSource) Rates() (map[string]float64, error) {
+	f, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rates map[string]float64
+	if strings.EqualFold(filepath.Ext(s.Path), ".json") {
+		rates, err = decodeRatesJSON(f)
+	} else {
+		rates, err = decodeECB(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	return rates, nil
+}
+
+// StaticSource is a fixed set of rates
+type StaticSource map[string]float64
+
+// DefaultStaticRates are the ECB reference rates of 12 July 2024, used by
+// the static source when no rates are given
+var DefaultStaticRates = StaticSource{
+	"USD": 1.0890, "JPY": 172.02, "GBP": 0.84125, "CHF": 0.9763,
+	"AUD": 1.6077, "CAD": 1.4860, "CNY": 7.9036, "SEK": 11.5365,
+}
+
+func (s StaticSource) Rates() (map[string]float64, error) {
+	rates := make(map[string]float64, len(s))
+	for k, v := range s {
+		rates[k] = v
+	}
+	return rates, nil
+}
+
+// ParseStaticRates parses comma separated CODE=rate pairs such as
+// USD=1.09,GBP=0.84
+func ParseStaticRates(s string) (StaticSource, error) {
+	rates := StaticSource{}
+	for _, kv := range strings.Split(s, ",") {
+		code, rate, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			return nil, fmt.Errorf("static rate %q is not CODE=rate", kv)
+		}
+		r, err := parseRate(code, rate)
+		if err != nil {
+			return nil, err
+		}
+		rates[strings.TrimSpace(code)] = r
+	}
+	return rates, nil
+}
+
+// decodeECB parses an ECB eurofxref XML document
+func decodeECB(r io.Reader) (map[string]float64, error) {
+	md := &Cubes{}
+	if err := xml.NewDecoder(r).Decode(md); err != nil {
+		return nil, fmt.Errorf("unable to decode ECB rates: %w", err)
+	}
+	if len(md.CubeData) == 0 {
+		return nil, fmt.Errorf("no rates in ECB data")
+	}
+
+	rates := make(map[string]float64, len(md.CubeData))
+	for _, v := range md.CubeData {
+		r, err := parseRate(v.Currency, v.Rate)
+		if err != nil {
+			return nil, err
+		}
+		rates[v.Currency] = r
+	}
+
+	return rates, nil
+}
+
+// ratesJSON is the JSON fixture format
+type ratesJSON struct {
+	Rates map[string]float64 `json:"rates"`
+}
+
+func decodeRatesJSON(r io.Reader) (map[string]float64, error) {
+	rj := &ratesJSON{}
+	if err := json.NewDecoder(r).Decode(rj); err != nil {
+		return nil, fmt.Errorf("unable to decode rates: %w", err)
+	}
+	if len(rj.Rates) == 0 {
+		return nil, fmt.Errorf("no rates in JSON data")
+	}
+	for k, v := range rj.Rates {
+		if v <= 0 {
+			return nil, fmt.Errorf("rate for %s must be positive, got %v", k, v)
+		}
+	}
+
+	return rj.Rates, nil
+}
+
+func parseRate(currency, rate string) (float64, error) {
+	r, err := strconv.ParseFloat(strings.TrimSpace(rate), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate for %s: %w", currency, err)
+	}
+	if r <= 0 {
+		return 0, fmt.Errorf("rate for %s must be positive, got %v", currency, r)
+	}
+	return r, nil
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestECBSource(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/eurofxref-daily.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.Write([]byte("<gesmes:Envelope><Cube><Cube time='2024-07-12'>"))
+			return
+		}
+		w.Write(fixture)
+	}))
+	defer srv.Close()
+
+	rates, err := (&ECBSource{URL: srv.URL}).Rates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 30 || rates["GBP"] != 0.84125 {
+		t.Fatalf("expected 30 rates with GBP at 0.84125, got %v", rates)
+	}
+
+	_, err = (&ECBSource{URL: srv.URL + "/broken"}).Rates()
+	if err == nil || !strings.Contains(err.Error(), "unable to decode ECB rates") {
+		t.Fatalf("expected the decode error, got %v", err)
+	}
+}
+
+func TestFileSourceJSON(t *testing.T) {
+	rates, err := (&FileSource{"testdata/rates.json"}).Rates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 3 || rates["JPY"] != 172.02 {
+		t.Fatalf("expected 3 rates with JPY at 172.02, got %v", rates)
+	}
+}
+
+func TestGetRateSource(t *testing.T) {
+	src, err := GetRateSource("static", "USD=1.1, GBP=0.85")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, _ := src.Rates()
+	if len(rates) != 2 || rates["USD"] != 1.1 || rates["GBP"] != 0.85 {
+		t.Fatalf("unexpected static rates %v", rates)
+	}
+
+	for _, c := range [][2]string{{"static", "USD=abc"}, {"static", "USD=-1"}, {"file", ""}, {"ftp", ""}} {
+		if _, err := GetRateSource(c[0], c[1]); err == nil {
+			t.Errorf("expected an error for %s %q", c[0], c[1])
+		}
+	}
+}
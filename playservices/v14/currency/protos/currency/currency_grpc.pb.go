@@ -24,6 +24,19 @@ const _ = grpc.SupportPackageIsVersion7
 type CurrencyClient interface {
 	GetRate(ctx context.Context, in *RateRequest, opts ...grpc.CallOption) (*RateResponse, error)
 	SubscribeRates(ctx context.Context, opts ...grpc.CallOption) (Currency_SubscribeRatesClient, error)
+	// GetHistoricalRate returns the rate fixed on a date, or on the last
+	// business day before it when there was no fixing that day
+	GetHistoricalRate(ctx context.Context, in *HistoricalRateRequest, opts ...grpc.CallOption) (*HistoricalRateResponse, error)
+	// GetRateSeries returns the rate of every fixing between two dates
+	GetRateSeries(ctx context.Context, in *RateSeriesRequest, opts ...grpc.CallOption) (*RateSeriesResponse, error)
+	// ListCurrencies returns the currencies which have a rate
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
+	// GetRateV2 is GetRate with ISO 4217 codes, a currency without a rate
+	// is NotFound
+	GetRateV2(ctx context.Context, in *RateRequestV2, opts ...grpc.CallOption) (*RateResponseV2, error)
+	// GetRates returns the rate from a base into every destination, all
+	// from the same version of the rates
+	GetRates(ctx context.Context, in *RatesRequest, opts ...grpc.CallOption) (*RatesResponse, error)
 }
 
 type currencyClient struct {
@@ -53,7 +66,7 @@ func (c *currencyClient) SubscribeRates(ctx context.Context, opts ...grpc.CallOp
 }
 
 type Currency_SubscribeRatesClient interface {
-	Send(*RateRequest) error
+	Send(*SubscribeRatesRequest) error
 	Recv() (*StreamingRateResponse, error)
 	grpc.ClientStream
 }
@@ -62,7 +75,7 @@ type currencySubscribeRatesClient struct {
 	grpc.ClientStream
 }
 
-func (x *currencySubscribeRatesClient) Send(m *RateRequest) error {
+func (x *currencySubscribeRatesClient) Send(m *SubscribeRatesRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
@@ -74,12 +87,70 @@ func (x *currencySubscribeRatesClient) Recv() (*StreamingRateResponse, error) {
 	return m, nil
 }
 
+func (c *currencyClient) GetHistoricalRate(ctx context.Context, in *HistoricalRateRequest, opts ...grpc.CallOption) (*HistoricalRateResponse, error) {
+	out := new(HistoricalRateResponse)
+	err := c.cc.Invoke(ctx, "/Currency/GetHistoricalRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyClient) GetRateSeries(ctx context.Context, in *RateSeriesRequest, opts ...grpc.CallOption) (*RateSeriesResponse, error) {
+	out := new(RateSeriesResponse)
+	err := c.cc.Invoke(ctx, "/Currency/GetRateSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/Currency/ListCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyClient) GetRateV2(ctx context.Context, in *RateRequestV2, opts ...grpc.CallOption) (*RateResponseV2, error) {
+	out := new(RateResponseV2)
+	err := c.cc.Invoke(ctx, "/Currency/GetRateV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyClient) GetRates(ctx context.Context, in *RatesRequest, opts ...grpc.CallOption) (*RatesResponse, error) {
+	out := new(RatesResponse)
+	err := c.cc.Invoke(ctx, "/Currency/GetRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CurrencyServer is the server API for Currency service.
 // All implementations must embed UnimplementedCurrencyServer
 // for forward compatibility
 type CurrencyServer interface {
 	GetRate(context.Context, *RateRequest) (*RateResponse, error)
 	SubscribeRates(Currency_SubscribeRatesServer) error
+	// GetHistoricalRate returns the rate fixed on a date, or on the last
+	// business day before it when there was no fixing that day
+	GetHistoricalRate(context.Context, *HistoricalRateRequest) (*HistoricalRateResponse, error)
+	// GetRateSeries returns the rate of every fixing between two dates
+	GetRateSeries(context.Context, *RateSeriesRequest) (*RateSeriesResponse, error)
+	// ListCurrencies returns the currencies which have a rate
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	// GetRateV2 is GetRate with ISO 4217 codes, a currency without a rate
+	// is NotFound
+	GetRateV2(context.Context, *RateRequestV2) (*RateResponseV2, error)
+	// GetRates returns the rate from a base into every destination, all
+	// from the same version of the rates
+	GetRates(context.Context, *RatesRequest) (*RatesResponse, error)
 	mustEmbedUnimplementedCurrencyServer()
 }
 
@@ -93,6 +164,21 @@ func (UnimplementedCurrencyServer) GetRate(context.Context, *RateRequest) (*Rate
 func (UnimplementedCurrencyServer) SubscribeRates(Currency_SubscribeRatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeRates not implemented")
 }
+func (UnimplementedCurrencyServer) GetHistoricalRate(context.Context, *HistoricalRateRequest) (*HistoricalRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricalRate not implemented")
+}
+func (UnimplementedCurrencyServer) GetRateSeries(context.Context, *RateSeriesRequest) (*RateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateSeries not implemented")
+}
+func (UnimplementedCurrencyServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedCurrencyServer) GetRateV2(context.Context, *RateRequestV2) (*RateResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateV2 not implemented")
+}
+func (UnimplementedCurrencyServer) GetRates(context.Context, *RatesRequest) (*RatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRates not implemented")
+}
 func (UnimplementedCurrencyServer) mustEmbedUnimplementedCurrencyServer() {}
 
 // UnsafeCurrencyServer may be embedded to opt out of forward compatibility for this service.
@@ -130,7 +216,7 @@ func _Currency_SubscribeRates_Handler(srv interface{}, stream grpc.ServerStream)
 
 type Currency_SubscribeRatesServer interface {
 	Send(*StreamingRateResponse) error
-	Recv() (*RateRequest, error)
+	Recv() (*SubscribeRatesRequest, error)
 	grpc.ServerStream
 }
 
@@ -142,14 +228,104 @@ func (x *currencySubscribeRatesServer) Send(m *StreamingRateResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *currencySubscribeRatesServer) Recv() (*RateRequest, error) {
-	m := new(RateRequest)
+func (x *currencySubscribeRatesServer) Recv() (*SubscribeRatesRequest, error) {
+	m := new(SubscribeRatesRequest)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
+func _Currency_GetHistoricalRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoricalRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServer).GetHistoricalRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Currency/GetHistoricalRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServer).GetHistoricalRate(ctx, req.(*HistoricalRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Currency_GetRateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServer).GetRateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Currency/GetRateSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServer).GetRateSeries(ctx, req.(*RateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Currency_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Currency/ListCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Currency_GetRateV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServer).GetRateV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Currency/GetRateV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServer).GetRateV2(ctx, req.(*RateRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Currency_GetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServer).GetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Currency/GetRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServer).GetRates(ctx, req.(*RatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Currency_ServiceDesc is the grpc.ServiceDesc for Currency service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +337,26 @@ var Currency_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRate",
 			Handler:    _Currency_GetRate_Handler,
 		},
+		{
+			MethodName: "GetHistoricalRate",
+			Handler:    _Currency_GetHistoricalRate_Handler,
+		},
+		{
+			MethodName: "GetRateSeries",
+			Handler:    _Currency_GetRateSeries_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _Currency_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "GetRateV2",
+			Handler:    _Currency_GetRateV2_Handler,
+		},
+		{
+			MethodName: "GetRates",
+			Handler:    _Currency_GetRates_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
 	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
@@ -16,8 +21,20 @@ import (
 )
 
 var (
-	port     = flag.Int("port", 9092, "The server port")
-	grpcAddr string
+	port               = flag.Int("port", 9092, "The server port")
+	rateSource         = flag.String("rate-source", "", "Where the rates come from: ecb, file or static, defaults to RATE_SOURCE or ecb")
+	rateSourceLocation = flag.String("rate-source-location", "", "URL for ecb, fixture path for file or CODE=rate pairs for static, defaults to RATE_SOURCE_LOCATION")
+	rateHistory        = flag.String("rate-history", "", "Comma separated paths or URLs of ECB rate history XML, defaults to RATE_HISTORY")
+	subQueue           = flag.Int("subscription-queue", 0, "Rate updates queued for each subscriber, defaults to SUBSCRIPTION_QUEUE or 64")
+	slowConsumer       = flag.String("slow-consumer", "", "What happens to a subscriber whose queue is full: drop or disconnect, defaults to SLOW_CONSUMER_POLICY or drop")
+	simModel           = flag.String("sim-model", "", "How the rates move between fixings: none, walk or ou, defaults to SIM_MODEL or walk")
+	simInterval        = flag.String("sim-interval", "", "How often the rates move, defaults to SIM_INTERVAL or 3s")
+	rateRefresh        = flag.String("rate-refresh", "", "How often the fixings are reloaded from the rate source, defaults to RATE_REFRESH or 24h")
+	simSeed            = flag.String("sim-seed", "", "Seed of the simulation, defaults to SIM_SEED or the clock")
+	simVolatility      = flag.String("sim-volatility", "", "Volatility of a tick with CODE=volatility overrides such as 0.01,JPY=0.02, defaults to SIM_VOLATILITY or 0.01")
+	healthPort         = flag.Int("health-port", 0, "Port of the HTTP /healthz and /readyz probes, defaults to HEALTH_PORT or 9093")
+	healthcheck        = flag.Bool("healthcheck", false, "Check /readyz of a running server and exit, for container health checks")
+	grpcAddr           string
 )
 
 func main() {
@@ -31,17 +48,103 @@ func main() {
 	}
 	grpcAddr = os.Getenv("GRPC_ADDRESS")
 
+	if *healthPort == 0 {
+		*healthPort, _ = strconv.Atoi(os.Getenv("HEALTH_PORT"))
+	}
+	if *healthPort == 0 {
+		*healthPort = 9093
+	}
+	if *healthcheck {
+		os.Exit(checkHealth(*healthPort))
+	}
+
 	log.Info("Here are some data: ", zap.Any("grpcAddr: ", grpcAddr), zap.Any("port: ", *port))
 
-	erhandler, err := data.GetExchangeRatesHandler(log)
+	if *rateSource == "" {
+		*rateSource = os.Getenv("RATE_SOURCE")
+	}
+	if *rateSourceLocation == "" {
+		*rateSourceLocation = os.Getenv("RATE_SOURCE_LOCATION")
+	}
+	src, err := data.GetRateSource(*rateSource, *rateSourceLocation)
+	if err != nil {
+		log.Fatal("invalid rate source", zap.Error(err))
+	}
+
+	erhandler, err := data.GetExchangeRatesHandler(src, log)
 	if err != nil {
 		log.Error("error creating new handler", zap.Error(err))
 	}
-	gs := grpc.NewServer()
-	csh := server.GetCurrencyServerHandler(erhandler, log)
+	if *rateHistory == "" {
+		*rateHistory = os.Getenv("RATE_HISTORY")
+	}
+	history := data.NewHistoryStore()
+	for _, loc := range strings.Split(*rateHistory, ",") {
+		if loc = strings.TrimSpace(loc); loc == "" {
+			continue
+		}
+		if err := history.LoadLocation(loc); err != nil {
+			log.Error("unable to load rate history", zap.String("location", loc), zap.Error(err))
+		}
+	}
+	log.Info("loaded rate history", zap.Int("days", history.Len()))
+
+	if *subQueue == 0 {
+		*subQueue, _ = strconv.Atoi(os.Getenv("SUBSCRIPTION_QUEUE"))
+	}
+	if *slowConsumer == "" {
+		*slowConsumer = os.Getenv("SLOW_CONSUMER_POLICY")
+	}
+	policy, err := server.ParseSlowConsumerPolicy(*slowConsumer)
+	if err != nil {
+		log.Fatal("invalid slow consumer policy", zap.Error(err))
+	}
+
+	if *simModel == "" {
+		*simModel = os.Getenv("SIM_MODEL")
+	}
+	if *simInterval == "" {
+		*simInterval = os.Getenv("SIM_INTERVAL")
+	}
+	if *rateRefresh == "" {
+		*rateRefresh = os.Getenv("RATE_REFRESH")
+	}
+	if *simSeed == "" {
+		*simSeed = os.Getenv("SIM_SEED")
+	}
+	if *simVolatility == "" {
+		*simVolatility = os.Getenv("SIM_VOLATILITY")
+	}
+	sim, err := data.ParseSimulationConfig(*simModel, *simInterval, *rateRefresh, *simSeed, *simVolatility)
+	if err != nil {
+		log.Fatal("invalid rate simulation", zap.Error(err))
+	}
+
+	metrics := server.NewMetrics(erhandler)
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(server.UnaryInterceptors(log, metrics)...),
+		grpc.ChainStreamInterceptor(server.StreamInterceptors(log, metrics)...),
+	)
+	csh := server.GetCurrencyServerHandler(erhandler, history, sim, server.SubscriptionConfig{QueueSize: *subQueue, Policy: policy}, metrics, log)
 
 	protos.RegisterCurrencyServer(gs, csh)
 
+	hh := server.NewHealth(erhandler, log)
+	hh.Register(gs)
+	go hh.Watch(context.Background(), time.Second)
+
+	hm := http.NewServeMux()
+	hm.Handle("/", hh.Handler())
+	hm.Handle("GET /metrics", metrics.Handler())
+
+	// the probes listen on every interface so that the healthcheck can reach them on localhost
+	go func() {
+		log.Info("Starting health and metrics server", zap.Int("port", *healthPort))
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", *healthPort), hm); err != nil {
+			log.Error("failed to serve health", zap.Error(err))
+		}
+	}()
+
 	reflection.Register(gs)
 
 	// Define the gRPC server options (e.g., port)
@@ -56,3 +159,20 @@ func main() {
 		log.Error("failed to serve", zap.Error(err))
 	}
 }
+
+// checkHealth returns 0 when the server on this host is ready
+func checkHealth(port int) int {
+	c := &http.Client{Timeout: 3 * time.Second}
+	resp, err := c.Get(fmt.Sprintf("http://127.0.0.1:%d/readyz", port))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		fmt.Fprintf(os.Stderr, "not ready: %s\n", resp.Status)
+		return 1
+	}
+	return 0
+}
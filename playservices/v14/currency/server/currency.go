@@ -2,7 +2,10 @@ package server
 
 import (
 	"context"
+	"errors"
 	"io"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
@@ -10,60 +13,72 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CurrencyServerHandler implements protos.CurrencyServer.
 type CurrencyServerHandler struct {
 	l   *zap.Logger
 	e   *data.ExchangeRatesHandler
-	sub map[protos.Currency_SubscribeRatesServer][]*protos.RateRequest
+	h   *data.HistoryStore
+	sub *registry
+	sim data.SimulationConfig
 
 	protos.UnimplementedCurrencyServer
 }
 
 // GetCurrencyServerHandler creates a new instance of CurrencyServerHandler.
-func GetCurrencyServerHandler(e *data.ExchangeRatesHandler, log *zap.Logger) protos.CurrencyServer {
+func GetCurrencyServerHandler(e *data.ExchangeRatesHandler, h *data.HistoryStore, sim data.SimulationConfig, sc SubscriptionConfig, m *Metrics, log *zap.Logger) protos.CurrencyServer {
 	c := &CurrencyServerHandler{
 		l:   log,
 		e:   e,
-		sub: make(map[protos.Currency_SubscribeRatesServer][]*protos.RateRequest),
+		h:   h,
+		sub: newRegistry(sc, m, log),
+		sim: sim,
 	}
 	go c.handleUpdates()
 	return c
 }
 
 func (c *CurrencyServerHandler) handleUpdates() {
-	ru := c.e.MonitorRates(3 * time.Second)
-	for range ru {
-		c.l.Info("Initialized streaming via handleUpdates")
+	ru := c.e.MonitorRates(c.sim)
+	for u := range ru {
+		c.l.Info("Rates updated", zap.Uint64("version", u.To), zap.Int("changes", len(u.Changes)), zap.Int("subscribers", c.sub.len()))
+		c.sub.m.ratesUpdated()
 
-		for k, v := range c.sub {
+		c.sub.each(func(s *subscriber) {
+			for _, p := range s.subscriptions() {
+				// only the pairs with a currency which moved have a new rate
+				_, bc := u.Changes[p.base.String()]
+				_, dc := u.Changes[p.destination.String()]
+				if !bc && !dc {
+					continue
+				}
 
-			for _, req := range v {
-				r, err := c.e.GetRates(req.GetBase().String(), req.GetDestination().String())
+				r, err := c.e.GetRates(p.base.String(), p.destination.String())
 				if err != nil {
-					c.l.Error("unable to get rates", zap.Error(err), zap.Any("base", req.GetBase().String()), zap.Any("destination", req.GetDestination().String()))
+					c.l.Error("unable to get rates", zap.Error(err), zap.Any("base", p.base.String()), zap.Any("destination", p.destination.String()))
+					continue
 				}
 
-				err = k.Send(&protos.StreamingRateResponse{
+				c.sub.enqueue(s, &protos.StreamingRateResponse{
 					Message: &protos.StreamingRateResponse_RateResponse{
-						RateResponse: &protos.RateResponse{Base: req.Base, Destination: req.Destination, Rate: r},
+						RateResponse: &protos.RateResponse{Base: p.base, Destination: p.destination, Rate: r},
 					},
 				})
-				c.l.Info("sent")
-
-				if err != nil {
-					c.l.Error("unable to send rates", zap.Error(err), zap.Any("base", req.GetBase().String()), zap.Any("destination", req.GetDestination().String()))
-				}
 			}
-		}
-
+		})
 	}
 }
 
+// logger returns the logger of the call, with its method and request id
+func (c *CurrencyServerHandler) logger(ctx context.Context) *zap.Logger {
+	return LoggerFromContext(ctx, c.l)
+}
+
 // GetRate implements the GetRate RPC method.
 func (c *CurrencyServerHandler) GetRate(ctx context.Context, req *protos.RateRequest) (*protos.RateResponse, error) {
-	c.l.Info("Handling GetRate", zap.Any("base", req.Base), zap.Any("destination", req.Destination))
+	c.logger(ctx).Info("Handling GetRate", zap.Any("base", req.Base), zap.Any("destination", req.Destination))
 
 	if req.Base == req.Destination {
 		err := status.Newf(
@@ -80,7 +95,7 @@ func (c *CurrencyServerHandler) GetRate(ctx context.Context, req *protos.RateReq
 
 	rate, err := c.e.GetRates(req.GetBase().String(), req.GetDestination().String())
 	if err != nil {
-		return nil, err
+		return nil, rateError(err)
 	}
 
 	response := &protos.RateResponse{
@@ -91,48 +106,250 @@ func (c *CurrencyServerHandler) GetRate(ctx context.Context, req *protos.RateReq
 	return response, nil
 }
 
-func (c *CurrencyServerHandler) SubscribeRates(srv protos.Currency_SubscribeRatesServer) error {
-	for {
-		req, err := srv.Recv()
-		if err == io.EOF {
-			c.l.Info("client has closed the connection")
-			break
+// GetRateV2 implements the GetRateV2 RPC method, it takes ISO 4217 codes
+// rather than the Currencies enum so currencies can be added without changing
+// the proto
+func (c *CurrencyServerHandler) GetRateV2(ctx context.Context, req *protos.RateRequestV2) (*protos.RateResponseV2, error) {
+	base, dest := normaliseCode(req.GetBase()), normaliseCode(req.GetDestination())
+	c.logger(ctx).Info("Handling GetRateV2", zap.String("base", base), zap.String("destination", dest))
+
+	return snapshotRate(c.e.Snapshot(), base, dest)
+}
+
+// GetRates implements the GetRates RPC method. Every rate is read from the
+// same snapshot so they all belong to the same update, a destination which
+// can not be resolved gets an error in the response instead of failing the
+// call
+func (c *CurrencyServerHandler) GetRates(ctx context.Context, req *protos.RatesRequest) (*protos.RatesResponse, error) {
+	base := normaliseCode(req.GetBase())
+	c.logger(ctx).Info("Handling GetRates", zap.String("base", base), zap.Strings("destinations", req.GetDestinations()))
+
+	if base == "" || len(req.GetDestinations()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "base and destination currencies are required")
+	}
+
+	snap := c.e.Snapshot()
+	if _, ok := snap.Rates[base]; !ok {
+		return nil, status.Errorf(codes.NotFound, "%v %s", data.ErrUnknownCurrency, base)
+	}
+
+	resp := &protos.RatesResponse{Base: base, Version: snap.Version}
+	seen := map[string]bool{}
+	for _, d := range req.GetDestinations() {
+		dest := normaliseCode(d)
+		if seen[dest] {
+			continue
 		}
+		seen[dest] = true
+
+		r, err := snapshotRate(snap, base, dest)
 		if err != nil {
-			c.l.Error("unable to read from client", zap.Error(err))
-			return nil
+			st, e := status.Convert(err).WithDetails(&protos.RateRequestV2{Base: base, Destination: dest})
+			if e != nil {
+				return nil, e
+			}
+			resp.Errors = append(resp.Errors, st.Proto())
+			continue
 		}
-		c.l.Info("Handle client request", zap.Any("base", req.Base.String()), zap.Any("dest", req.Destination.String()))
+		resp.Rates = append(resp.Rates, r)
+	}
 
-		rreq, ok := c.sub[srv]
-		if !ok {
-			rreq = []*protos.RateRequest{}
-		}
-		// check if already in the subscribe list and return a custom gRPC error
-		for _, r := range rreq {
-			// if we already have subscribe to this currency return an error
-			if r.Base == req.Base && r.Destination == req.Destination {
-				c.l.Error("Subscription already active", zap.Any("base", req.Base.String()), zap.Any("dest", req.Destination.String()))
-
-				grpcError := status.Newf(codes.AlreadyExists, "Subscription already active for rate")
-				grpcError, err = grpcError.WithDetails(req)
-				if err != nil {
-					c.l.Error("Unable to add metadata to error message", zap.Any("error", err))
-					continue
-				}
+	return resp, nil
+}
 
-				// Can't return error as that will terminate the connection, instead must send an error which
-				// can be handled by the client Recv stream.
-				srv.Send(&protos.StreamingRateResponse{
-					Message: &protos.StreamingRateResponse_Error{
-						Error: grpcError.Proto()},
-				})
+// snapshotRate returns the rate from base to dest in snap, updated when
+// either of them last changed
+func snapshotRate(snap *data.Snapshot, base, dest string) (*protos.RateResponseV2, error) {
+	if base == "" || dest == "" {
+		return nil, status.Error(codes.InvalidArgument, "base and destination currencies are required")
+	}
+	if base == dest {
+		return nil, status.Errorf(codes.InvalidArgument, "base currency %s cannot be the same as the destination currency %s", base, dest)
+	}
+
+	rate, err := snap.Rate(base, dest)
+	if err != nil {
+		return nil, rateError(err)
+	}
+
+	updated := snap.Updated[base]
+	if u := snap.Updated[dest]; u.After(updated) {
+		updated = u
+	}
+
+	return &protos.RateResponseV2{
+		Base:        base,
+		Destination: dest,
+		Rate:        rate,
+		Updated:     timestamppb.New(updated),
+	}, nil
+}
+
+// ListCurrencies implements the ListCurrencies RPC method, returning every
+// currency with a rate sorted by code
+func (c *CurrencyServerHandler) ListCurrencies(ctx context.Context, req *protos.ListCurrenciesRequest) (*protos.ListCurrenciesResponse, error) {
+	c.logger(ctx).Info("Handling ListCurrencies")
+
+	snap := c.e.Snapshot()
+	keys := make([]string, 0, len(snap.Rates))
+	for code := range snap.Rates {
+		keys = append(keys, code)
+	}
+	sort.Strings(keys)
+
+	resp := &protos.ListCurrenciesResponse{Currencies: make([]*protos.CurrencyInfo, 0, len(keys))}
+	for _, code := range keys {
+		resp.Currencies = append(resp.Currencies, &protos.CurrencyInfo{
+			Code:    code,
+			Rate:    snap.Rates[code],
+			Updated: timestamppb.New(snap.Updated[code]),
+		})
+	}
+
+	return resp, nil
+}
+
+// normaliseCode returns code as an upper case ISO 4217 code
+func normaliseCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// rateError converts an error looking up a rate to a gRPC status
+func rateError(err error) error {
+	if errors.Is(err, data.ErrUnknownCurrency) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// GetHistoricalRate implements the GetHistoricalRate RPC method.
+func (c *CurrencyServerHandler) GetHistoricalRate(ctx context.Context, req *protos.HistoricalRateRequest) (*protos.HistoricalRateResponse, error) {
+	rr := req.GetRequest()
+	c.logger(ctx).Info("Handling GetHistoricalRate", zap.Any("base", rr.GetBase()), zap.Any("destination", rr.GetDestination()), zap.String("date", req.GetDate()))
+
+	if rr.GetBase() == rr.GetDestination() {
+		return nil, status.Errorf(codes.InvalidArgument, "base currency %s cannot be the same as the destination currency %s", rr.GetBase(), rr.GetDestination())
+	}
+
+	date, err := time.Parse(data.DateLayout, req.GetDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date %q is not formatted as YYYY-MM-DD", req.GetDate())
+	}
+
+	rate, fixed, err := c.h.Rate(rr.GetBase().String(), rr.GetDestination().String(), date)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &protos.HistoricalRateResponse{
+		Base:        rr.GetBase(),
+		Destination: rr.GetDestination(),
+		Rate:        rate,
+		Date:        fixed.Format(data.DateLayout),
+	}, nil
+}
+
+// GetRateSeries implements the GetRateSeries RPC method.
+func (c *CurrencyServerHandler) GetRateSeries(ctx context.Context, req *protos.RateSeriesRequest) (*protos.RateSeriesResponse, error) {
+	c.logger(ctx).Info("Handling GetRateSeries", zap.Any("base", req.GetBase()), zap.Any("destination", req.GetDestination()), zap.String("from", req.GetFrom()), zap.String("to", req.GetTo()))
+
+	if req.GetBase() == req.GetDestination() {
+		return nil, status.Errorf(codes.InvalidArgument, "base currency %s cannot be the same as the destination currency %s", req.GetBase(), req.GetDestination())
+	}
+
+	from, err := time.Parse(data.DateLayout, req.GetFrom())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from %q is not formatted as YYYY-MM-DD", req.GetFrom())
+	}
+	to, err := time.Parse(data.DateLayout, req.GetTo())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "to %q is not formatted as YYYY-MM-DD", req.GetTo())
+	}
+
+	series, err := c.h.Series(req.GetBase().String(), req.GetDestination().String(), from, to)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &protos.RateSeriesResponse{
+		Base:        req.GetBase(),
+		Destination: req.GetDestination(),
+		Rates:       make([]*protos.DatedRate, 0, len(series)),
+	}
+	for _, r := range series {
+		resp.Rates = append(resp.Rates, &protos.DatedRate{Date: r.Date.Format(data.DateLayout), Rate: r.Rate})
+	}
+
+	return resp, nil
+}
+
+// SubscribeRates implements the SubscribeRates RPC method. The client sends a
+// request for every pair it wants updates for, or with unsubscribe set to stop
+// them, and the subscriptions are removed when the stream ends
+func (c *CurrencyServerHandler) SubscribeRates(srv protos.Currency_SubscribeRatesServer) error {
+	ctx, cancel := context.WithCancelCause(srv.Context())
+	s := c.sub.add(ctx, cancel, srv)
+	defer c.sub.remove(s)
+
+	errc := make(chan error, 1)
+	go func() {
+		for {
+			req, err := srv.Recv()
+			if err != nil {
+				errc <- err
+				return
 			}
+			c.handleSubscription(s, req)
+		}
+	}()
+
+	select {
+	case err := <-errc:
+		if err == io.EOF {
+			c.logger(ctx).Info("client has closed the connection")
+			return nil
 		}
+		c.logger(ctx).Error("unable to read from client", zap.Error(err))
+		return nil
+	case <-ctx.Done():
+		// the client went away or did not keep up with the updates
+		if cause := context.Cause(ctx); cause == errSlowConsumer {
+			return cause
+		}
+		return nil
+	}
+}
+
+func (c *CurrencyServerHandler) handleSubscription(s *subscriber, req *protos.SubscribeRatesRequest) {
+	c.logger(s.srv.Context()).Info("Handle client request", zap.Any("base", req.Base.String()), zap.Any("dest", req.Destination.String()), zap.Bool("unsubscribe", req.GetUnsubscribe()))
+
+	p := pair{req.GetBase(), req.GetDestination()}
+	if req.GetUnsubscribe() {
+		if !s.unsubscribe(p) {
+			c.sendError(s, req, status.Newf(codes.NotFound, "No subscription active for rate"))
+		}
+		return
+	}
+
+	// if we already have subscribe to this currency return an error, the
+	// subscription is not added a second time
+	if !s.subscribe(p) {
+		c.logger(s.srv.Context()).Error("Subscription already active", zap.Any("base", req.Base.String()), zap.Any("dest", req.Destination.String()))
+		c.sendError(s, req, status.Newf(codes.AlreadyExists, "Subscription already active for rate"))
+	}
+}
 
-		// all ok add to the collection
-		rreq = append(rreq, req)
-		c.sub[srv] = rreq
+// sendError queues an error for the client, returning it would terminate the
+// stream so it is sent as a message the client handles on Recv
+func (c *CurrencyServerHandler) sendError(s *subscriber, req *protos.SubscribeRatesRequest, st *status.Status) {
+	st, err := st.WithDetails(req)
+	if err != nil {
+		c.l.Error("Unable to add metadata to error message", zap.Any("error", err))
+		return
 	}
-	return nil
+
+	c.sub.enqueue(s, &protos.StreamingRateResponse{
+		Message: &protos.StreamingRateResponse_Error{
+			Error: st.Proto()},
+	})
 }
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRatesHandler(t *testing.T) *CurrencyServerHandler {
+	t.Helper()
+	e, err := data.GetExchangeRatesHandler(data.StaticSource{"USD": 1.1, "GBP": 0.85}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &CurrencyServerHandler{l: zap.NewNop(), e: e}
+}
+
+func TestGetRateV2(t *testing.T) {
+	c := newRatesHandler(t)
+
+	r, err := c.GetRateV2(context.Background(), &protos.RateRequestV2{Base: " gbp", Destination: "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gbp, usd := 0.85, 1.1
+	if r.GetBase() != "GBP" || r.GetRate() != gbp/usd {
+		t.Fatalf("expected GBP to USD at %v, got %v", gbp/usd, r)
+	}
+	if r.GetUpdated().AsTime().IsZero() {
+		t.Fatal("expected the update time to be set")
+	}
+
+	tests := map[string]struct {
+		base, dest string
+		code       codes.Code
+	}{
+		"unknown":     {"USD", "XXX", codes.NotFound},
+		"same":        {"usd", "USD", codes.InvalidArgument},
+		"missing":     {"", "USD", codes.InvalidArgument},
+		"unknown old": {"RUB", "EUR", codes.NotFound},
+	}
+	for name, tc := range tests {
+		_, err := c.GetRateV2(context.Background(), &protos.RateRequestV2{Base: tc.base, Destination: tc.dest})
+		if status.Code(err) != tc.code {
+			t.Errorf("%s: expected %v, got %v", name, tc.code, err)
+		}
+	}
+}
+
+func TestGetRateUnknownCurrency(t *testing.T) {
+	c := newRatesHandler(t)
+
+	_, err := c.GetRate(context.Background(), &protos.RateRequest{Base: protos.Currencies_JPY, Destination: protos.Currencies_USD})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+func TestListCurrencies(t *testing.T) {
+	c := newRatesHandler(t)
+
+	r, err := c.ListCurrencies(context.Background(), &protos.ListCurrenciesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, ci := range r.GetCurrencies() {
+		got = append(got, ci.GetCode())
+		if ci.GetRate() <= 0 || ci.GetUpdated().AsTime().IsZero() {
+			t.Errorf("expected %s to have a rate and update time, got %v", ci.GetCode(), ci)
+		}
+	}
+	if len(got) != 3 || got[0] != "EUR" || got[1] != "GBP" || got[2] != "USD" {
+		t.Fatalf("expected EUR, GBP and USD, got %v", got)
+	}
+}
+
+func TestGetRatesPartialFailure(t *testing.T) {
+	c := newRatesHandler(t)
+
+	r, err := c.GetRates(context.Background(), &protos.RatesRequest{Base: "usd", Destinations: []string{"GBP", "eur", "XXX", "GBP", "USD"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetVersion() != c.e.Snapshot().Version {
+		t.Fatalf("expected version %d, got %d", c.e.Snapshot().Version, r.GetVersion())
+	}
+
+	var got []string
+	for _, rr := range r.GetRates() {
+		got = append(got, rr.GetDestination())
+	}
+	if len(got) != 2 || got[0] != "GBP" || got[1] != "EUR" {
+		t.Fatalf("expected rates into GBP and EUR, got %v", got)
+	}
+
+	want := map[string]codes.Code{"XXX": codes.NotFound, "USD": codes.InvalidArgument}
+	if len(r.GetErrors()) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), r.GetErrors())
+	}
+	for _, e := range r.GetErrors() {
+		st := status.FromProto(e)
+		d := st.Details()
+		if len(d) != 1 {
+			t.Fatalf("expected the pair in the error details, got %v", d)
+		}
+		pair, ok := d[0].(*protos.RateRequestV2)
+		if !ok || want[pair.GetDestination()] != st.Code() {
+			t.Errorf("unexpected error %v for %v", st, d[0])
+		}
+	}
+
+	_, err = c.GetRates(context.Background(), &protos.RatesRequest{Base: "XXX", Destinations: []string{"USD"}})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown base, got %v", err)
+	}
+	_, err = c.GetRates(context.Background(), &protos.RatesRequest{Base: "USD"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without destinations, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Health reports whether the currency service can serve rates, which is when
+// the rates have been loaded and the monitor is running. It backs the
+// grpc.health.v1 service and the HTTP probes
+type Health struct {
+	l  *zap.Logger
+	e  *data.ExchangeRatesHandler
+	hs *health.Server
+}
+
+// HealthStatus is the body of the HTTP probes
+type HealthStatus struct {
+	Status         string `json:"status"`
+	RatesLoaded    bool   `json:"ratesLoaded"`
+	MonitorRunning bool   `json:"monitorRunning"`
+	RatesVersion   uint64 `json:"ratesVersion"`
+}
+
+// NewHealth returns the health of e, it is not serving until Watch has run
+func NewHealth(e *data.ExchangeRatesHandler, log *zap.Logger) *Health {
+	h := &Health{l: log, e: e, hs: health.NewServer()}
+	h.set(healthpb.HealthCheckResponse_NOT_SERVING)
+	return h
+}
+
+// Register adds the grpc.health.v1 service to gs
+func (h *Health) Register(gs *grpc.Server) {
+	healthpb.RegisterHealthServer(gs, h.hs)
+}
+
+// Watch updates the gRPC serving status every interval until ctx is done
+func (h *Health) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		st := healthpb.HealthCheckResponse_NOT_SERVING
+		if h.ready() {
+			st = healthpb.HealthCheckResponse_SERVING
+		}
+		if st != last {
+			h.l.Info("currency service health changed", zap.Stringer("status", st))
+			h.set(st)
+			last = st
+		}
+
+		select {
+		case <-ctx.Done():
+			h.hs.Shutdown()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// set changes the status of the whole server and of the Currency service
+func (h *Health) set(st healthpb.HealthCheckResponse_ServingStatus) {
+	h.hs.SetServingStatus("", st)
+	h.hs.SetServingStatus(protos.Currency_ServiceDesc.ServiceName, st)
+}
+
+func (h *Health) ready() bool {
+	return h.e.Loaded() && h.e.Monitoring()
+}
+
+// Handler returns the HTTP probes, /healthz answers while the process is up
+// and /readyz only once the rates can be served
+func (h *Health) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		h.write(w, http.StatusOK)
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		code := http.StatusOK
+		if !h.ready() {
+			code = http.StatusServiceUnavailable
+		}
+		h.write(w, code)
+	})
+	return mux
+}
+
+func (h *Health) write(w http.ResponseWriter, code int) {
+	hs := HealthStatus{
+		Status:         "ok",
+		RatesLoaded:    h.e.Loaded(),
+		MonitorRunning: h.e.Monitoring(),
+		RatesVersion:   h.e.Snapshot().Version,
+	}
+	if code != http.StatusOK {
+		hs.Status = "unavailable"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(hs)
+}
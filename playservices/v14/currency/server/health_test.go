@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
+	"go.uber.org/zap"
+)
+
+func TestHealthProbes(t *testing.T) {
+	e, err := data.GetExchangeRatesHandler(data.DefaultStaticRates, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHealth(e, zap.NewNop())
+
+	probe := func(path string) int {
+		rw := httptest.NewRecorder()
+		h.Handler().ServeHTTP(rw, httptest.NewRequest(http.MethodGet, path, nil))
+		return rw.Code
+	}
+
+	if c := probe("/healthz"); c != http.StatusOK {
+		t.Fatalf("expected /healthz to be ok, got %d", c)
+	}
+	if c := probe("/readyz"); c != http.StatusServiceUnavailable {
+		t.Fatalf("expected /readyz to be unavailable before the monitor runs, got %d", c)
+	}
+
+	cfg := data.DefaultSimulation
+	cfg.Interval = time.Hour
+	e.MonitorRates(cfg)
+	if c := probe("/readyz"); c != http.StatusOK {
+		t.Fatalf("expected /readyz to be ok, got %d", c)
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+	"runtime/debug"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDMetadata is the metadata key carrying the id of a call, it is
+// taken from the caller or generated and sent back in the response header
+const RequestIDMetadata = "x-request-id"
+
+// requestIDRe limits the request ids taken from callers to a safe length
+// and character set, the same as product-api does for X-Request-Id
+var requestIDRe = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+type loggerKeyType struct{}
+
+var loggerKey loggerKeyType
+
+// LoggerFromContext returns the logger of the call, which carries its
+// method and request id, or l outside of a call
+func LoggerFromContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	if cl, ok := ctx.Value(loggerKey).(*zap.Logger); ok {
+		return cl
+	}
+	return l
+}
+
+// UnaryInterceptors returns the interceptors of the unary calls, outermost
+// first: request id, access log, metrics and panic recovery
+func UnaryInterceptors(l *zap.Logger, m *Metrics) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(withRequestID(ctx, l, info.FullMethod), req)
+		},
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			resp, err := handler(ctx, req)
+			logCall(ctx, l, start, err)
+			return resp, err
+		},
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			start := time.Now()
+			resp, err := handler(ctx, req)
+			m.observe(info.FullMethod, status.Code(err), time.Since(start))
+			return resp, err
+		},
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+			defer recoverCall(ctx, l, &err)
+			return handler(ctx, req)
+		},
+	}
+}
+
+// StreamInterceptors returns the interceptors of the streams, in the same
+// order as UnaryInterceptors. The access log and metrics cover the whole
+// life of a stream
+func StreamInterceptors(l *zap.Logger, m *Metrics) []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &contextStream{ss, withRequestID(ss.Context(), l, info.FullMethod)})
+		},
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			start := time.Now()
+			err := handler(srv, ss)
+			logCall(ss.Context(), l, start, err)
+			return err
+		},
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			start := time.Now()
+			err := handler(srv, ss)
+			m.observe(info.FullMethod, status.Code(err), time.Since(start))
+			return err
+		},
+		func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+			defer recoverCall(ss.Context(), l, &err)
+			return handler(srv, ss)
+		},
+	}
+}
+
+// contextStream replaces the context of a stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (cs *contextStream) Context() context.Context {
+	return cs.ctx
+}
+
+// withRequestID returns ctx with the logger of the call, the request id
+// comes from the incoming metadata or is generated when there is none or
+// it is not a safe id
+func withRequestID(ctx context.Context, l *zap.Logger, method string) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(RequestIDMetadata); len(v) > 0 {
+			id = v[0]
+		}
+	}
+	if !requestIDRe.MatchString(id) {
+		b := make([]byte, 16)
+		rand.Read(b)
+		id = hex.EncodeToString(b)
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, id))
+
+	return context.WithValue(ctx, loggerKey, l.With(zap.String("method", method), zap.String("request_id", id)))
+}
+
+func logCall(ctx context.Context, l *zap.Logger, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []zap.Field{zap.Stringer("code", code), zap.Duration("duration", time.Since(start))}
+
+	cl := LoggerFromContext(ctx, l)
+	if code == codes.OK || code == codes.Canceled {
+		cl.Info("grpc call", fields...)
+		return
+	}
+	cl.Warn("grpc call", append(fields, zap.Error(err))...)
+}
+
+// recoverCall turns a panic of a handler into an Internal error so that it
+// does not take the server down
+func recoverCall(ctx context.Context, l *zap.Logger, err *error) {
+	if r := recover(); r != nil {
+		LoggerFromContext(ctx, l).Error("panic in grpc handler", zap.Any("panic", r), zap.ByteString("stack", debug.Stack()))
+		*err = status.Error(codes.Internal, "internal error")
+	}
+}
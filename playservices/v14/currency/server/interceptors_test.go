@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestInterceptorsRecoverAndPropagateRequestID(t *testing.T) {
+	l := zap.NewNop()
+	m := NewMetrics(nil)
+
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(UnaryInterceptors(l, m)...),
+		grpc.ChainStreamInterceptor(StreamInterceptors(l, m)...),
+	)
+	// without an exchange rates handler GetRate panics
+	protos.RegisterCurrencyServer(gs, &CurrencyServerHandler{l: l, sub: newRegistry(SubscriptionConfig{}, m, l)})
+	go gs.Serve(lis)
+	defer gs.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), RequestIDMetadata, "req-1")
+	var header metadata.MD
+	_, err = protos.NewCurrencyClient(conn).GetRate(ctx, &protos.RateRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP}, grpc.Header(&header))
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected the panic to become Internal, got %v", err)
+	}
+	if id := header.Get(RequestIDMetadata); len(id) != 1 || id[0] != "req-1" {
+		t.Fatalf("expected the request id to be sent back, got %v", id)
+	}
+
+	if n := testutil.ToFloat64(m.requests.WithLabelValues("/Currency/GetRate", "Internal")); n != 1 {
+		t.Fatalf("expected the failed call to be counted, got %v", n)
+	}
+
+	// the server is still up and generates an id when the caller has none
+	_, err = protos.NewCurrencyClient(conn).GetRate(context.Background(), &protos.RateRequest{}, grpc.Header(&header))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for the same currencies, got %v", err)
+	}
+	if id := header.Get(RequestIDMetadata); len(id) != 1 || len(id[0]) != 32 {
+		t.Fatalf("expected a generated request id, got %v", id)
+	}
+
+	// an id which could forge log lines or bloat headers is replaced
+	for _, bad := range []string{"req-1\nlevel=error forged", strings.Repeat("a", 65)} {
+		ctx = metadata.AppendToOutgoingContext(context.Background(), RequestIDMetadata, bad)
+		protos.NewCurrencyClient(conn).GetRate(ctx, &protos.RateRequest{}, grpc.Header(&header))
+		if id := header.Get(RequestIDMetadata); len(id) != 1 || len(id[0]) != 32 {
+			t.Fatalf("expected the request id %q to be replaced, got %v", bad, id)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/currency/data"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+)
+
+// Metrics are the Prometheus series of the currency service. A nil Metrics
+// records nothing
+type Metrics struct {
+	reg *prometheus.Registry
+
+	requests     *prometheus.CounterVec
+	latency      *prometheus.HistogramVec
+	streams      prometheus.Gauge
+	sendFailures *prometheus.CounterVec
+	rateUpdates  prometheus.Counter
+}
+
+// NewMetrics returns the metrics of the service, with the rates of e when it
+// is not nil
+func NewMetrics(e *data.ExchangeRatesHandler) *Metrics {
+	m := &Metrics{
+		reg: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "currency_grpc_requests_total",
+			Help: "gRPC calls handled, by method and status code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "currency_grpc_request_duration_seconds",
+			Help:    "Duration of the gRPC calls, streams last until they are closed.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+		streams: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "currency_subscribe_rates_streams",
+			Help: "SubscribeRates streams open.",
+		}),
+		sendFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "currency_subscribe_rates_send_failures_total",
+			Help: "Rate updates which did not reach a subscriber: error when the send failed, dropped or disconnected when the subscriber was too slow.",
+		}, []string{"reason"}),
+		rateUpdates: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "currency_rate_updates_total",
+			Help: "Updates of the rates sent to the subscribers.",
+		}),
+	}
+
+	m.reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.latency, m.streams, m.sendFailures, m.rateUpdates,
+	)
+	if e != nil {
+		m.reg.MustRegister(newRatesCollector(e))
+	}
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.reg, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) observe(method string, code codes.Code, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(method, code.String()).Inc()
+	m.latency.WithLabelValues(method).Observe(d.Seconds())
+}
+
+func (m *Metrics) streamOpened() {
+	if m != nil {
+		m.streams.Inc()
+	}
+}
+
+func (m *Metrics) streamClosed() {
+	if m != nil {
+		m.streams.Dec()
+	}
+}
+
+func (m *Metrics) sendFailed(reason string) {
+	if m != nil {
+		m.sendFailures.WithLabelValues(reason).Inc()
+	}
+}
+
+func (m *Metrics) ratesUpdated() {
+	if m != nil {
+		m.rateUpdates.Inc()
+	}
+}
+
+// ratesCollector reads the current rates when the metrics are scraped
+type ratesCollector struct {
+	e         *data.ExchangeRatesHandler
+	rate      *prometheus.Desc
+	version   *prometheus.Desc
+	refreshed *prometheus.Desc
+}
+
+func newRatesCollector(e *data.ExchangeRatesHandler) *ratesCollector {
+	return &ratesCollector{
+		e:         e,
+		rate:      prometheus.NewDesc("currency_rate", "Amount of the currency one euro buys.", []string{"currency"}, nil),
+		version:   prometheus.NewDesc("currency_rates_version", "Version of the rates, it goes up every time they change.", nil, nil),
+		refreshed: prometheus.NewDesc("currency_source_last_refresh_timestamp_seconds", "When the rates were last loaded from the rate source, such as the ECB.", nil, nil),
+	}
+}
+
+func (rc *ratesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rc.rate
+	ch <- rc.version
+	ch <- rc.refreshed
+}
+
+func (rc *ratesCollector) Collect(ch chan<- prometheus.Metric) {
+	s := rc.e.Snapshot()
+	for k, v := range s.Rates {
+		ch <- prometheus.MustNewConstMetric(rc.rate, prometheus.GaugeValue, v, k)
+	}
+	ch <- prometheus.MustNewConstMetric(rc.version, prometheus.GaugeValue, float64(s.Version))
+
+	if t := rc.e.Refreshed(); !t.IsZero() {
+		ch <- prometheus.MustNewConstMetric(rc.refreshed, prometheus.GaugeValue, float64(t.UnixNano())/1e9)
+	}
+}
@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SlowConsumerPolicy decides what happens to a subscriber whose queue of
+// outgoing messages is full
+type SlowConsumerPolicy int
+
+const (
+	// DropOldest discards the oldest queued message to make room
+	DropOldest SlowConsumerPolicy = iota
+	// Disconnect ends the stream with ResourceExhausted
+	Disconnect
+)
+
+// ParseSlowConsumerPolicy parses drop or disconnect
+func ParseSlowConsumerPolicy(s string) (SlowConsumerPolicy, error) {
+	switch s {
+	case "", "drop":
+		return DropOldest, nil
+	case "disconnect":
+		return Disconnect, nil
+	default:
+		return 0, fmt.Errorf("unknown slow consumer policy %q, expected drop or disconnect", s)
+	}
+}
+
+// DefaultQueueSize is how many messages wait for a subscriber by default
+const DefaultQueueSize = 64
+
+// SubscriptionConfig configures how rate updates are delivered to the
+// SubscribeRates streams
+type SubscriptionConfig struct {
+	QueueSize int
+	Policy    SlowConsumerPolicy
+}
+
+// sendGrace is how long a removed subscriber's goroutine is waited for,
+// when it is stuck in Send the stream ending makes Send fail
+const sendGrace = 5 * time.Second
+
+// errSlowConsumer is the cause of a disconnected slow subscriber
+var errSlowConsumer = status.Error(codes.ResourceExhausted, "subscriber is not keeping up with the rate updates")
+
+type pair struct {
+	base, destination protos.Currencies
+}
+
+// subscriber is a SubscribeRates stream. Messages for it are queued and sent
+// by its own goroutine, so a slow client never holds up the others and Send
+// is only called from one goroutine
+type subscriber struct {
+	srv    protos.Currency_SubscribeRatesServer
+	cancel context.CancelCauseFunc
+	queue  chan *protos.StreamingRateResponse
+	done   chan struct{}
+
+	mu    sync.Mutex
+	pairs map[pair]bool
+}
+
+// registry holds the open SubscribeRates streams
+type registry struct {
+	l     *zap.Logger
+	cfg   SubscriptionConfig
+	m     *Metrics
+	grace time.Duration
+
+	mu   sync.RWMutex
+	subs map[*subscriber]bool
+}
+
+func newRegistry(cfg SubscriptionConfig, m *Metrics, l *zap.Logger) *registry {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultQueueSize
+	}
+	return &registry{l: l, cfg: cfg, m: m, grace: sendGrace, subs: map[*subscriber]bool{}}
+}
+
+// add registers srv and starts the goroutine sending its messages until ctx
+// is done, cancel ends the stream with a cause
+func (r *registry) add(ctx context.Context, cancel context.CancelCauseFunc, srv protos.Currency_SubscribeRatesServer) *subscriber {
+	s := &subscriber{
+		srv:    srv,
+		cancel: cancel,
+		queue:  make(chan *protos.StreamingRateResponse, r.cfg.QueueSize),
+		done:   make(chan struct{}),
+		pairs:  map[pair]bool{},
+	}
+
+	r.mu.Lock()
+	r.subs[s] = true
+	r.mu.Unlock()
+	r.m.streamOpened()
+
+	go r.send(ctx, s)
+
+	return s
+}
+
+// remove unregisters s and waits for its goroutine, the stream must not be
+// written to once its handler has returned. A client which stopped reading
+// leaves the goroutine blocked in Send, it is only waited for a grace period
+func (r *registry) remove(s *subscriber) {
+	r.mu.Lock()
+	delete(r.subs, s)
+	r.mu.Unlock()
+	r.m.streamClosed()
+
+	s.cancel(nil)
+	select {
+	case <-s.done:
+	case <-time.After(r.grace):
+		r.l.Warn("subscriber is blocked in send, ending the stream")
+	}
+}
+
+// len returns the number of open streams
+func (r *registry) len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.subs)
+}
+
+// each calls fn for every open stream
+func (r *registry) each(fn func(s *subscriber)) {
+	r.mu.RLock()
+	subs := make([]*subscriber, 0, len(r.subs))
+	for s := range r.subs {
+		subs = append(subs, s)
+	}
+	r.mu.RUnlock()
+
+	for _, s := range subs {
+		fn(s)
+	}
+}
+
+func (r *registry) send(ctx context.Context, s *subscriber) {
+	defer close(s.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-s.queue:
+			if err := s.srv.Send(m); err != nil {
+				r.l.Error("unable to send to subscriber", zap.Error(err))
+				r.m.sendFailed("error")
+				s.cancel(err)
+				return
+			}
+		}
+	}
+}
+
+// enqueue queues m for s without blocking, applying the slow consumer
+// policy when the queue is full
+func (r *registry) enqueue(s *subscriber, m *protos.StreamingRateResponse) {
+	select {
+	case s.queue <- m:
+		return
+	default:
+	}
+
+	if r.cfg.Policy == Disconnect {
+		r.l.Warn("disconnecting slow subscriber")
+		r.m.sendFailed("disconnected")
+		s.cancel(errSlowConsumer)
+		return
+	}
+
+	r.l.Warn("dropping the oldest message of a slow subscriber")
+	r.m.sendFailed("dropped")
+	select {
+	case <-s.queue:
+	default:
+	}
+	select {
+	case s.queue <- m:
+	default:
+	}
+}
+
+// subscribe adds the pair to s, it returns false when it was already there
+func (s *subscriber) subscribe(p pair) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pairs[p] {
+		return false
+	}
+	s.pairs[p] = true
+	return true
+}
+
+// unsubscribe removes the pair from s, it returns false when it was not there
+func (s *subscriber) unsubscribe(p pair) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.pairs[p] {
+		return false
+	}
+	delete(s.pairs, p)
+	return true
+}
+
+// subscriptions returns the pairs s is subscribed to
+func (s *subscriber) subscriptions() []pair {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ps := make([]pair, 0, len(s.pairs))
+	for p := range s.pairs {
+		ps = append(ps, p)
+	}
+	return ps
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeStream is a SubscribeRates stream driven by the test
+type fakeStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	recv  chan *protos.SubscribeRatesRequest
+	sent  chan *protos.StreamingRateResponse
+	block chan struct{}
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{
+		ctx:  ctx,
+		recv: make(chan *protos.SubscribeRatesRequest),
+		sent: make(chan *protos.StreamingRateResponse, 16),
+	}
+}
+
+func (f *fakeStream) Context() context.Context { return f.ctx }
+
+func (f *fakeStream) Recv() (*protos.SubscribeRatesRequest, error) {
+	select {
+	case <-f.ctx.Done():
+		return nil, f.ctx.Err()
+	case r, ok := <-f.recv:
+		if !ok {
+			return nil, io.EOF
+		}
+		return r, nil
+	}
+}
+
+func (f *fakeStream) Send(m *protos.StreamingRateResponse) error {
+	if f.block != nil {
+		<-f.block
+	}
+	f.sent <- m
+	return nil
+}
+
+func newTestHandler(cfg SubscriptionConfig) *CurrencyServerHandler {
+	return &CurrencyServerHandler{l: zap.NewNop(), sub: newRegistry(cfg, nil, zap.NewNop())}
+}
+
+func serve(c *CurrencyServerHandler, f *fakeStream) chan error {
+	done := make(chan error, 1)
+	go func() { done <- c.SubscribeRates(f) }()
+	return done
+}
+
+func expectError(t *testing.T, f *fakeStream, code codes.Code) {
+	t.Helper()
+	select {
+	case m := <-f.sent:
+		if c := codes.Code(m.GetError().GetCode()); c != code {
+			t.Fatalf("expected a %v error, got %v", code, m)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected a %v error", code)
+	}
+}
+
+func subscriptions(c *CurrencyServerHandler) int {
+	n := 0
+	c.sub.each(func(s *subscriber) { n += len(s.subscriptions()) })
+	return n
+}
+
+func TestSubscribeRatesDuplicateAndUnsubscribe(t *testing.T) {
+	c := newTestHandler(SubscriptionConfig{})
+	f := newFakeStream(context.Background())
+	done := serve(c, f)
+
+	req := &protos.SubscribeRatesRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP}
+	f.recv <- req
+	f.recv <- req
+	expectError(t, f, codes.AlreadyExists)
+	if n := subscriptions(c); n != 1 {
+		t.Fatalf("expected the duplicate not to be added, got %d subscriptions", n)
+	}
+
+	f.recv <- &protos.SubscribeRatesRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP, Unsubscribe: true}
+	f.recv <- &protos.SubscribeRatesRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP, Unsubscribe: true}
+	expectError(t, f, codes.NotFound)
+	if n := subscriptions(c); n != 0 {
+		t.Fatalf("expected no subscriptions, got %d", n)
+	}
+
+	close(f.recv)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if c.sub.len() != 0 {
+		t.Fatal("expected the stream to be removed")
+	}
+}
+
+func TestSubscribeRatesRemovedOnCancel(t *testing.T) {
+	c := newTestHandler(SubscriptionConfig{})
+	ctx, cancel := context.WithCancel(context.Background())
+	f := newFakeStream(ctx)
+	done := serve(c, f)
+
+	f.recv <- &protos.SubscribeRatesRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP}
+	if c.sub.len() != 1 {
+		t.Fatal("expected the stream to be registered")
+	}
+
+	cancel()
+	<-done
+	if c.sub.len() != 0 {
+		t.Fatal("expected the stream to be removed")
+	}
+}
+
+func TestSlowConsumerDisconnect(t *testing.T) {
+	c := newTestHandler(SubscriptionConfig{QueueSize: 1, Policy: Disconnect})
+	c.sub.grace = 10 * time.Millisecond
+	f := newFakeStream(context.Background())
+	f.block = make(chan struct{})
+	defer close(f.block)
+	done := serve(c, f)
+
+	f.recv <- &protos.SubscribeRatesRequest{Base: protos.Currencies_USD, Destination: protos.Currencies_GBP}
+	m := &protos.StreamingRateResponse{}
+	for i := 0; i < 3; i++ {
+		c.sub.each(func(s *subscriber) { c.sub.enqueue(s, m) })
+	}
+
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Fatalf("expected ResourceExhausted, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the slow subscriber to be disconnected")
+	}
+}
+
+func TestSlowConsumerDropOldest(t *testing.T) {
+	r := newRegistry(SubscriptionConfig{QueueSize: 2}, nil, zap.NewNop())
+	s := &subscriber{queue: make(chan *protos.StreamingRateResponse, 2)}
+
+	for i := 1; i <= 3; i++ {
+		r.enqueue(s, &protos.StreamingRateResponse{Message: &protos.StreamingRateResponse_RateResponse{
+			RateResponse: &protos.RateResponse{Rate: float64(i)},
+		}})
+	}
+
+	if first := (<-s.queue).GetRateResponse().GetRate(); first != 2 {
+		t.Fatalf("expected the oldest message to be dropped, got %v first", first)
+	}
+}
@@ -27,6 +27,9 @@ import (
 const (
 	shutdownTime   = 6 * time.Second
 	httpServerAddr = "0.0.0.0:9090"
+
+	defaultTrashRetention = 30 * 24 * time.Hour
+	trashPurgeInterval    = time.Hour
 )
 
 var (
@@ -38,26 +41,51 @@ var (
 	mdb_password string
 	mdb_cluster  string
 	mdb_appname  string
+
+	// productStore selects the ProductStore backend, either "mongo" or "memory"
+	productStore string
+	// trashRetention is how long deleted products are kept before they are purged
+	trashRetention = defaultTrashRetention
+	// rateMaxAge is how long a cached exchange rate is served before it is fetched again
+	rateMaxAge = data.DefaultRateMaxAge
+	// rateStaleBudget is how old a cached rate can be and still be served while the currency service is down
+	rateStaleBudget = data.DefaultRateStaleBudget
 )
 
-func setupHTTPServer(l *zap.Logger, v *data.Validation, cc protos.CurrencyClient, db *data.ProductsDB) *http.Server {
+func setupHTTPServer(l *zap.Logger, v *data.Validation, cc protos.CurrencyClient, db data.ProductStore) *http.Server {
 
 	ph := handlers.NewProducts(l, v, cc, db)
 
 	sm := mux.NewRouter()
+	sm.Use(ph.MiddlewareRequestID)
 
 	// Handlers for API endpoints
 	getR := sm.Methods(http.MethodGet).Subrouter()
+	getR.HandleFunc("/products/search", ph.Search)
+	getR.HandleFunc("/products/trash", ph.ListTrash)
+	getR.HandleFunc("/products/export", ph.Export)
+	getR.HandleFunc("/products/stream", ph.Stream)
+	getR.HandleFunc("/products/stream/ws", ph.StreamWebSocket)
+	getR.HandleFunc("/products", ph.ListSingleProduct).Queries("id", "{id:[0-9a-fA-F]{24}}")
 	getR.HandleFunc("/products", ph.ListAll).Queries("currency", "{[A-Z{3}]}")
 	getR.HandleFunc("/products", ph.ListAll)
-	getR.HandleFunc("/products", ph.ListSingleProduct)
-	getR.HandleFunc("/products", ph.ListSingleProduct).Queries("id", "{id:[0-9a-fA-F]{24}}", "currency", "{currency:[A-Z]{3}}")
+	getR.HandleFunc("/health", ph.Health)
 	getR.HandleFunc("/migrate", ph.MigrateDocs).Queries("currency", "{currency:[A-Z]{3}}")
 
 	putR := sm.Methods(http.MethodPut).Subrouter()
 	putR.HandleFunc("/products", ph.Update).Queries("id", "{id:[0-9a-fA-F]{24}}", "currency", "{currency:[A-Z]{3}}")
 	putR.Use(ph.MiddlewareValidateProduct)
 
+	patchR := sm.Methods(http.MethodPatch).Subrouter()
+	patchR.HandleFunc("/products/{id:[0-9a-fA-F]{24}}", ph.Patch)
+
+	restoreR := sm.Methods(http.MethodPost).Subrouter()
+	restoreR.HandleFunc("/products/{id:[0-9a-fA-F]{24}}/restore", ph.Restore)
+
+	// rows of an import are validated one by one so it skips the middleware
+	importR := sm.Methods(http.MethodPost).Subrouter()
+	importR.HandleFunc("/products/import", ph.Import)
+
 	postR := sm.Methods(http.MethodPost).Subrouter()
 	postR.HandleFunc("/products", ph.Create)
 	postR.Use(ph.MiddlewareValidateProduct)
@@ -97,16 +125,29 @@ func main() {
 	mdb_appname = os.Getenv("MDB_APPNAME")
 	grpcAddr = os.Getenv("GRPC_ADDRESS")
 	grpcPort = os.Getenv("GRPC_PORT")
+	productStore = os.Getenv("PRODUCT_STORE")
+	if s := os.Getenv("TRASH_RETENTION"); s != "" {
+		trashRetention, err = time.ParseDuration(s)
+		if err != nil {
+			log.Fatalf("invalid TRASH_RETENTION %q: %v", s, err)
+		}
+	}
 
-	if mdb_username == "" || mdb_password == "" || mdb_cluster == "" || mdb_appname == "" {
-		log.Fatalf("MongoDB credentials or cluster not properly set")
+	if s := os.Getenv("RATE_MAX_AGE"); s != "" {
+		rateMaxAge, err = time.ParseDuration(s)
+		if err != nil {
+			log.Fatalf("invalid RATE_MAX_AGE %q: %v", s, err)
+		}
 	}
 
-	mdb_URI := fmt.Sprintf("mongodb+srv://%s:%s@%s/?retryWrites=true&w=majority&appName=%s",
-		mdb_username, mdb_password, mdb_cluster, mdb_appname)
+	if s := os.Getenv("RATE_STALE_BUDGET"); s != "" {
+		rateStaleBudget, err = time.ParseDuration(s)
+		if err != nil {
+			log.Fatalf("invalid RATE_STALE_BUDGET %q: %v", s, err)
+		}
+	}
 
 	grpcAddress := fmt.Sprintf("%s:%s", grpcAddr, grpcPort)
-	l.Info("[INFO]", zap.Any("mdb_URI: ", mdb_URI))
 	l.Info("[INFO]", zap.Any("grpcAddress: ", grpcAddress), zap.Any("grpcPort: ", grpcPort))
 	grpcConn := data.GetgrpcClient(grpcAddress, l)
 	defer grpcConn.Close()
@@ -114,18 +155,32 @@ func main() {
 	cc := protos.NewCurrencyClient(grpcConn)
 
 	v := data.NewValidation()
-	db := data.GetProductsDB(cc, l, mongoClient)
-	mongoClient, err = db.GetMongoClient(mdb_URI)
-	if err != nil {
-		l.Error("error getting mongo client", zap.Error(err))
-	}
-	defer db.DisconnectMongoClient()
 
-	err = db.GetMongoCollection("Cluster0", "ecommerce")
-	if err != nil {
-		l.Error("error retrieving mongo collection", zap.Error(err))
+	var db data.ProductStore
+	switch productStore {
+	case "memory":
+		l.Info("[INFO] using the in-memory product store")
+		mdb := data.GetMemoryProductsDB(cc, l)
+		mdb.SetRateMaxAge(rateMaxAge)
+		mdb.SetRateStaleBudget(rateStaleBudget)
+		defer mdb.StopRateUpdates()
+		db = mdb
+	case "", "mongo":
+		mdb := getMongoProductsDB(cc, l)
+		defer mdb.DisconnectMongoClient()
+		mdb.SetRateMaxAge(rateMaxAge)
+		mdb.SetRateStaleBudget(rateStaleBudget)
+		defer mdb.StopRateUpdates()
+		db = mdb
+	default:
+		log.Fatalf("unknown PRODUCT_STORE %q, expected mongo or memory", productStore)
 	}
 
+	// Purge products which have been in the trash longer than the retention
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go data.PurgeTrash(purgeCtx, db, trashRetention, trashPurgeInterval, l)
+
 	// Setup HTTP server
 	httpServer := setupHTTPServer(l, v, cc, db)
 
@@ -155,3 +210,34 @@ func main() {
 
 	l.Info("HTTP server shutdown complete.")
 }
+
+// getMongoProductsDB connects to the MongoDB cluster configured through the MDB_* variables
+func getMongoProductsDB(cc protos.CurrencyClient, l *zap.Logger) *data.ProductsDB {
+	if mdb_username == "" || mdb_password == "" || mdb_cluster == "" || mdb_appname == "" {
+		log.Fatalf("MongoDB credentials or cluster not properly set")
+	}
+
+	mdb_URI := fmt.Sprintf("mongodb+srv://%s:%s@%s/?retryWrites=true&w=majority&appName=%s",
+		mdb_username, mdb_password, mdb_cluster, mdb_appname)
+	l.Info("[INFO]", zap.Any("mdb_URI: ", mdb_URI))
+
+	db := data.GetProductsDB(cc, l, mongoClient)
+	var err error
+	mongoClient, err = db.GetMongoClient(mdb_URI)
+	if err != nil {
+		l.Error("error getting mongo client", zap.Error(err))
+	}
+
+	err = db.GetMongoCollection("Cluster0", "ecommerce")
+	if err != nil {
+		l.Error("error retrieving mongo collection", zap.Error(err))
+	}
+
+	// without the unique sku index duplicate SKUs would be stored silently
+	err = db.EnsureIndexes(context.Background())
+	if err != nil {
+		l.Fatal("error ensuring mongo indexes", zap.Error(err))
+	}
+
+	return db
+}
@@ -0,0 +1,152 @@
+package data
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// breakerThreshold is the number of consecutive failures which open the breaker
+	breakerThreshold = 5
+	// breakerCooldown is how long the breaker stays open before a trial call
+	breakerCooldown = 30 * time.Second
+)
+
+// BreakerState is the state of the circuit breaker around the currency service
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through
+	BreakerClosed BreakerState = iota
+	// BreakerOpen fails every call without making it
+	BreakerOpen
+	// BreakerHalfOpen lets a single trial call through after the cooldown
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// BreakerStats is a snapshot of the circuit breaker for monitoring
+type BreakerStats struct {
+	State               string `json:"state"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	// Calls is the number of calls which were let through
+	Calls int64 `json:"calls"`
+	// Failures is the number of calls which failed
+	Failures int64 `json:"failures"`
+	// Rejected is the number of calls refused while the breaker was open
+	Rejected int64 `json:"rejected"`
+	// Opened is the number of times the breaker tripped
+	Opened int64 `json:"opened"`
+}
+
+// circuitBreaker stops calling a failing dependency for a cooldown after
+// a run of consecutive failures, then lets one trial call decide whether
+// to close again. It is safe for concurrent use
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	state    BreakerState
+	openedAt time.Time
+	// gen is incremented on every change of state, the outcome of a call
+	// allowed in an earlier generation does not change the state
+	gen uint64
+	// trial is set while the half open trial call is in flight
+	trial bool
+	stats BreakerStats
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// setState moves the breaker to state and starts a new generation, the
+// caller must hold the lock
+func (b *circuitBreaker) setState(state BreakerState) {
+	b.state = state
+	b.gen++
+	b.trial = false
+}
+
+// cool moves an open breaker to half open once the cooldown has passed,
+// the caller must hold the lock
+func (b *circuitBreaker) cool() {
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		b.setState(BreakerHalfOpen)
+	}
+}
+
+// allow reports whether a call can be made and the generation it is made
+// in, every allowed call must be followed by a call to done with it
+func (b *circuitBreaker) allow() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cool()
+
+	switch {
+	case b.state == BreakerOpen, b.state == BreakerHalfOpen && b.trial:
+		b.stats.Rejected++
+		return b.gen, false
+	case b.state == BreakerHalfOpen:
+		b.trial = true
+	}
+
+	b.stats.Calls++
+	return b.gen, true
+}
+
+// done records the outcome of a call allowed in generation gen. A call
+// allowed before the breaker last changed state is only counted, so a slow
+// call from before the breaker opened can not close it and a half open
+// breaker only listens to its trial call
+func (b *circuitBreaker) done(gen uint64, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if failed {
+		b.stats.Failures++
+	}
+	if gen != b.gen {
+		return
+	}
+
+	if !failed {
+		if b.state != BreakerClosed {
+			b.setState(BreakerClosed)
+		}
+		b.stats.ConsecutiveFailures = 0
+		return
+	}
+
+	b.stats.ConsecutiveFailures++
+	if b.state == BreakerHalfOpen || b.stats.ConsecutiveFailures >= b.threshold {
+		b.stats.Opened++
+		b.setState(BreakerOpen)
+		b.openedAt = b.now()
+	}
+}
+
+// snapshot returns the current state and counters
+func (b *circuitBreaker) snapshot() BreakerStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.cool()
+
+	s := b.stats
+	s.State = b.state.String()
+	return s
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(3, time.Minute)
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		gen, ok := b.allow()
+		if !ok {
+			t.Fatalf("call %d rejected while closed", i)
+		}
+		b.done(gen, true)
+	}
+	if _, ok := b.allow(); ok {
+		t.Fatal("expected the breaker to be open after 3 failures")
+	}
+
+	now = now.Add(time.Minute)
+	gen, ok := b.allow()
+	if !ok {
+		t.Fatal("expected a trial call after the cooldown")
+	}
+	if _, ok := b.allow(); ok {
+		t.Fatal("expected a single trial call while half open")
+	}
+	b.done(gen, false)
+
+	gen, ok = b.allow()
+	if !ok {
+		t.Fatal("expected the breaker to close after a successful trial")
+	}
+	b.done(gen, false)
+
+	s := b.snapshot()
+	if s.State != "closed" || s.Opened != 1 || s.Failures != 3 || s.Rejected != 2 || s.Calls != 5 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+func TestCircuitBreakerStaleResults(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(1, time.Minute)
+	b.now = func() time.Time { return now }
+
+	// a slow call is allowed while closed and another one opens the breaker
+	slow, _ := b.allow()
+	gen, _ := b.allow()
+	b.done(gen, true)
+
+	// the slow call succeeding afterwards does not close it
+	b.done(slow, false)
+	if _, ok := b.allow(); ok {
+		t.Fatal("expected a success from before the breaker opened to be ignored")
+	}
+
+	// nor does it end the trial of a half open breaker
+	now = now.Add(time.Minute)
+	trial, ok := b.allow()
+	if !ok {
+		t.Fatal("expected a trial call after the cooldown")
+	}
+	b.done(slow, false)
+	if _, ok := b.allow(); ok {
+		t.Fatal("expected a single trial call while half open")
+	}
+	if s := b.snapshot(); s.State != "half-open" {
+		t.Fatalf("expected a half open breaker, got %+v", s)
+	}
+
+	b.done(trial, true)
+	if s := b.snapshot(); s.State != "open" || s.Opened != 2 {
+		t.Fatalf("expected the failed trial to open the breaker again, got %+v", s)
+	}
+}
+
+// downCurrency is a currency client whose GetRate and GetRates always fail
+// as if the service could not be reached
+type downCurrency struct {
+	protos.CurrencyClient
+	calls int
+}
+
+func (d *downCurrency) GetRate(ctx context.Context, in *protos.RateRequest, opts ...grpc.CallOption) (*protos.RateResponse, error) {
+	d.calls++
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func (d *downCurrency) GetRates(ctx context.Context, in *protos.RatesRequest, opts ...grpc.CallOption) (*protos.RatesResponse, error) {
+	d.calls++
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func TestConvertPricesDegraded(t *testing.T) {
+	cc := &downCurrency{}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+	err := rc.convertPrices(context.Background(), ps, "USD")
+	if !errors.Is(err, ErrConversionUnavailable) {
+		t.Fatalf("expected ErrConversionUnavailable, got %v", err)
+	}
+	if ps[0].Price.Currency != "EUR" || ps[0].Price.String() != "2.45" {
+		t.Fatalf("expected the stored price to be left alone, got %v %s", ps[0].Price, ps[0].Price.Currency)
+	}
+
+	// once the breaker opens the service is no longer called
+	for i := 0; i < breakerThreshold+5; i++ {
+		rc.convertPrices(context.Background(), ps, "USD")
+	}
+	if cc.calls != breakerThreshold {
+		t.Fatalf("expected %d calls before the breaker opened, got %d", breakerThreshold, cc.calls)
+	}
+	if s := rc.BreakerStats(); s.State != "open" {
+		t.Fatalf("expected an open breaker, got %+v", s)
+	}
+
+	// a rate which is too old for the cache but within the stale budget is used
+	rc.rates.entries[rateKey("EUR", "USD")] = rateEntry{1.1, time.Now().Add(-time.Hour / 2)}
+	if err := rc.convertPrices(context.Background(), ps, "USD"); err != nil {
+		t.Fatal(err)
+	}
+	if ps[0].Price.Currency != "USD" || ps[0].Price.String() != "2.70" {
+		t.Fatalf("expected 2.70 USD from the stale rate, got %s %s", ps[0].Price, ps[0].Price.Currency)
+	}
+
+	rc.SetRateStaleBudget(time.Minute)
+	if err := rc.convertPrices(context.Background(), ps, "GBP"); !errors.Is(err, ErrConversionUnavailable) {
+		t.Fatalf("expected ErrConversionUnavailable without a cached GBP rate, got %v", err)
+	}
+}
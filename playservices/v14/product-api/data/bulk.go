@@ -0,0 +1,217 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// BulkFormat is a file format supported by the bulk import and export
+type BulkFormat string
+
+const (
+	FormatCSV    BulkFormat = "csv"
+	FormatNDJSON BulkFormat = "ndjson"
+)
+
+// ContentType returns the MIME type of the format
+func (f BulkFormat) ContentType() string {
+	if f == FormatCSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
+
+// csvHeader is the column order written by the CSV encoder, the decoder
+// accepts the columns in any order and ignores id and version
+var csvHeader = []string{"id", "name", "description", "price", "currency", "sku", "version"}
+
+// ProductEncoder writes products one at a time
+type ProductEncoder interface {
+	Encode(p *Product) error
+	Flush() error
+}
+
+// NewProductEncoder returns an encoder writing the format to w
+func NewProductEncoder(f BulkFormat, w io.Writer) ProductEncoder {
+	if f == FormatCSV {
+		return &csvEncoder{w: csv.NewWriter(w)}
+	}
+	return &ndjsonEncoder{json.NewEncoder(w)}
+}
+
+type ndjsonEncoder struct {
+	e *json.Encoder
+}
+
+func (ne *ndjsonEncoder) Encode(p *Product) error {
+	return ne.e.Encode(p)
+}
+
+func (ne *ndjsonEncoder) Flush() error {
+	return nil
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (ce *csvEncoder) Encode(p *Product) error {
+	if !ce.wroteHeader {
+		ce.wroteHeader = true
+		if err := ce.w.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+
+	return ce.w.Write([]string{
+		p.ID,
+		p.Name,
+		p.Description,
+		p.Price.String(),
+		p.Price.Currency,
+		p.SKU,
+		strconv.FormatInt(p.Version, 10),
+	})
+}
+
+func (ce *csvEncoder) Flush() error {
+	// an empty export still gets a header so that it can be re-imported
+	if !ce.wroteHeader {
+		ce.wroteHeader = true
+		ce.w.Write(csvHeader)
+	}
+	ce.w.Flush()
+	return ce.w.Error()
+}
+
+// RowError is a problem with a single row of an import, the row number
+// is 1 based and does not count the CSV header
+type RowError struct {
+	Row      int      `json:"row"`
+	Messages []string `json:"messages"`
+}
+
+func (re *RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", re.Row, strings.Join(re.Messages, ", "))
+}
+
+// ProductDecoder reads products one at a time. Decode returns a *RowError
+// for a row which can not be parsed, the next call moves on to the next row.
+// It returns io.EOF once there are no more rows
+type ProductDecoder interface {
+	Decode() (*Product, error)
+}
+
+// NewProductDecoder returns a decoder reading the format from r
+func NewProductDecoder(f BulkFormat, r io.Reader) ProductDecoder {
+	if f == FormatCSV {
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+		return &csvDecoder{r: cr}
+	}
+	return &ndjsonDecoder{r: bufio.NewReaderSize(r, maxNDJSONRow)}
+}
+
+// maxNDJSONRow is the longest NDJSON row which is decoded, a longer row
+// fails on its own without aborting the rest of the import
+const maxNDJSONRow = 1 << 20
+
+type ndjsonDecoder struct {
+	r   *bufio.Reader
+	row int
+}
+
+func (nd *ndjsonDecoder) Decode() (*Product, error) {
+	for {
+		b, err := nd.r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			nd.row++
+			// skip to the end of the row
+			for err == bufio.ErrBufferFull {
+				_, err = nd.r.ReadSlice('\n')
+			}
+			if err != nil && err != io.EOF {
+				return nil, err
+			}
+			return nil, &RowError{nd.row, []string{fmt.Sprintf("row is longer than %d bytes", maxNDJSONRow)}}
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		line := bytes.TrimSpace(b)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+		nd.row++
+
+		p := &Product{}
+		if err := json.Unmarshal(line, p); err != nil {
+			return nil, &RowError{nd.row, []string{err.Error()}}
+		}
+		return p, nil
+	}
+}
+
+type csvDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+	row     int
+}
+
+func (cd *csvDecoder) Decode() (*Product, error) {
+	if cd.columns == nil {
+		header, err := cd.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		cd.columns = map[string]int{}
+		for i, h := range header {
+			cd.columns[strings.ToLower(strings.TrimSpace(h))] = i
+		}
+		for _, c := range []string{"name", "price", "sku"} {
+			if _, ok := cd.columns[c]; !ok {
+				return nil, fmt.Errorf("csv header is missing the %s column", c)
+			}
+		}
+	}
+
+	rec, err := cd.r.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	cd.row++
+	if err != nil {
+		return nil, &RowError{cd.row, []string{err.Error()}}
+	}
+
+	field := func(name string) string {
+		if i, ok := cd.columns[name]; ok && i < len(rec) {
+			return strings.TrimSpace(rec[i])
+		}
+		return ""
+	}
+
+	price, err := NewMoney(field("price"), field("currency"))
+	if err != nil {
+		return nil, &RowError{cd.row, []string{err.Error()}}
+	}
+
+	return &Product{
+		Name:        field("name"),
+		Description: field("description"),
+		Price:       price,
+		SKU:         field("sku"),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestBulkRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+
+	for _, f := range []BulkFormat{FormatCSV, FormatNDJSON} {
+		var buf bytes.Buffer
+		enc := NewProductEncoder(f, &buf)
+		if err := db.ExportProducts(ctx, func(p *Product) error { return enc.Encode(p) }); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		dec := NewProductDecoder(f, &buf)
+		var got Products
+		for {
+			p, err := dec.Decode()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%s: %v", f, err)
+			}
+			got = append(got, p)
+		}
+
+		if len(got) != len(ProductList) {
+			t.Fatalf("%s: expected %d products, got %d", f, len(ProductList), len(got))
+		}
+		for i, p := range got {
+			want := ProductList[i]
+			if p.Name != want.Name || p.SKU != want.SKU || p.Price != want.Price {
+				t.Fatalf("%s: expected %+v, got %+v", f, want, p)
+			}
+		}
+	}
+}
+
+func TestCSVDecoderRowErrors(t *testing.T) {
+	in := "sku,name,price,currency\n" +
+		"abc-def-ghi,Mocha,3.10,EUR\n" +
+		"abc-def-jkl,Cortado,2.455,EUR\n" +
+		"abc-def-mno,Flat White,2.80\n"
+
+	dec := NewProductDecoder(FormatCSV, strings.NewReader(in))
+	var names []string
+	var rows []int
+	for {
+		p, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		var re *RowError
+		if errors.As(err, &re) {
+			rows = append(rows, re.Row)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, p.Name)
+	}
+
+	if len(names) != 2 || names[0] != "Mocha" || names[1] != "Flat White" {
+		t.Fatalf("expected Mocha and Flat White, got %v", names)
+	}
+	if len(rows) != 1 || rows[0] != 2 {
+		t.Fatalf("expected an error for row 2, got %v", rows)
+	}
+
+	dec = NewProductDecoder(FormatCSV, strings.NewReader("name,price\nMocha,3.10\n"))
+	if _, err := dec.Decode(); err == nil {
+		t.Fatal("expected an error for a header without sku")
+	}
+}
+
+func TestNDJSONDecoderLongRow(t *testing.T) {
+	long := `{"name":"` + strings.Repeat("x", maxNDJSONRow) + `","price":{"amount":"1.00","currency":"EUR"},"sku":"abc-def-ghi"}`
+	in := long + "\n" +
+		`{"name":"Mocha","price":{"amount":"3.10","currency":"EUR"},"sku":"abc-def-jkl"}` + "\n" +
+		long
+
+	dec := NewProductDecoder(FormatNDJSON, strings.NewReader(in))
+	var names []string
+	var rows []int
+	for {
+		p, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		var re *RowError
+		if errors.As(err, &re) {
+			rows = append(rows, re.Row)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, p.Name)
+	}
+
+	if len(names) != 1 || names[0] != "Mocha" {
+		t.Fatalf("expected Mocha, got %v", names)
+	}
+	if len(rows) != 2 || rows[0] != 1 || rows[1] != 3 {
+		t.Fatalf("expected rows 1 and 3 to fail, got %v", rows)
+	}
+}
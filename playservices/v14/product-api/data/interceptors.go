@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the HTTP header carrying the id of a request, it is
+// passed on to the currency service as the x-request-id metadata
+const RequestIDHeader = "X-Request-Id"
+
+const requestIDMetadata = "x-request-id"
+
+type requestIDKeyType struct{}
+
+var requestIDKey requestIDKeyType
+
+// WithRequestID returns a copy of ctx carrying the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the request id carried by ctx, or an empty string
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// NewRequestID returns a random request id
+func NewRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// outgoingRequestID adds the request id of ctx to the outgoing metadata, a
+// call made outside of a request gets an id of its own
+func outgoingRequestID(ctx context.Context) (context.Context, string) {
+	id := RequestID(ctx)
+	if id == "" {
+		id = NewRequestID()
+	}
+	return metadata.AppendToOutgoingContext(ctx, requestIDMetadata, id), id
+}
+
+// unaryClientInterceptor sends the request id with every call to the
+// currency service and logs the call
+func unaryClientInterceptor(l *zap.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, id := outgoingRequestID(ctx)
+
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		l.Info("grpc call",
+			zap.String("method", method),
+			zap.String("request_id", id),
+			zap.Stringer("code", status.Code(err)),
+			zap.Duration("duration", time.Since(start)),
+		)
+		return err
+	}
+}
+
+// streamClientInterceptor sends the request id when a stream is opened to
+// the currency service and logs the result
+func streamClientInterceptor(l *zap.Logger) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, id := outgoingRequestID(ctx)
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+
+		l.Info("grpc stream",
+			zap.String("method", method),
+			zap.String("request_id", id),
+			zap.Stringer("code", status.Code(err)),
+		)
+		return cs, err
+	}
+}
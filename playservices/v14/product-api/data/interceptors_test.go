@@ -0,0 +1,21 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestOutgoingRequestID(t *testing.T) {
+	ctx, id := outgoingRequestID(WithRequestID(context.Background(), "req-1"))
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if id != "req-1" || len(md.Get(requestIDMetadata)) != 1 || md.Get(requestIDMetadata)[0] != "req-1" {
+		t.Fatalf("expected the request id to be sent, got %q %v", id, md)
+	}
+
+	_, id = outgoingRequestID(context.Background())
+	if len(id) != 32 {
+		t.Fatalf("expected a generated request id, got %q", id)
+	}
+}
@@ -0,0 +1,283 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+// MemoryProductsDB is a ProductStore which keeps the products in process.
+// It is safe for concurrent use and is meant for local development and tests
+// where no MongoDB cluster is available
+type MemoryProductsDB struct {
+	*rateConverter
+	mu       sync.RWMutex
+	products map[primitive.ObjectID]*Product
+	order    []primitive.ObjectID
+	index    *searchIndex
+	// skus maps the SKU of every product outside the trash to its product
+	// the same way the unique index does in MongoDB
+	skus map[string]primitive.ObjectID
+}
+
+// GetMemoryProductsDB returns an in-memory store seeded with ProductList
+func GetMemoryProductsDB(c protos.CurrencyClient, l *zap.Logger) *MemoryProductsDB {
+	db := &MemoryProductsDB{
+		rateConverter: getRateConverter(c, l),
+		products:      make(map[primitive.ObjectID]*Product),
+		index:         newSearchIndex(),
+		skus:          make(map[string]primitive.ObjectID),
+	}
+
+	for _, p := range ProductList {
+		db.insert(p)
+	}
+
+	return db
+}
+
+// insert stores a copy of p under a new id, the caller must hold the lock
+func (db *MemoryProductsDB) insert(p *Product) string {
+	id := primitive.NewObjectID()
+
+	np := *p
+	np.ID = id.Hex()
+	np.Version = 1
+	np.DeletedAt = nil
+	np.DeletedBy = ""
+	db.products[id] = &np
+	db.order = append(db.order, id)
+	db.index.add(id, &np)
+	db.skus[np.SKU] = id
+
+	return np.ID
+}
+
+// GetProducts returns a page of copies of the stored products
+func (db *MemoryProductsDB) GetProducts(ctx context.Context, q ProductQuery, currency string, currencies ...string) (*ProductPage, error) {
+	db.mu.RLock()
+	all := Products{}
+	for _, id := range db.order {
+		np := *db.products[id]
+		all = append(all, &np)
+	}
+	db.mu.RUnlock()
+
+	page, err := q.page(all)
+	if err != nil {
+		return nil, err
+	}
+
+	// a failed conversion still returns the products in their stored currency
+	err = db.convertPrices(ctx, page.Items, currency, currencies...)
+	return page, err
+}
+
+// GetProductByID returns a copy of the product with the given id.
+// If a product is not found this function returns a ProductNotFound error
+func (db *MemoryProductsDB) GetProductByID(ctx context.Context, id primitive.ObjectID, currency string, currencies ...string) (*Product, error) {
+	db.mu.RLock()
+	p, ok := db.products[id]
+	if !ok || p.DeletedAt != nil {
+		db.mu.RUnlock()
+		return nil, ErrProductNotFound
+	}
+	np := *p
+	db.mu.RUnlock()
+
+	// a failed conversion still returns the products in their stored currency
+	err := db.convertPrices(ctx, Products{&np}, currency, currencies...)
+	return &np, err
+}
+
+// AddProduct stores copies of the given products and returns their new ids.
+// Products whose SKU is already taken are skipped and reported in an
+// ErrDuplicateSKU, the others are still stored
+func (db *MemoryProductsDB) AddProduct(ctx context.Context, p []*Product) ([]string, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var ids []string
+	var dup []SKUConflict
+	for i, prod := range p {
+		if id, ok := db.skus[prod.SKU]; ok {
+			dup = append(dup, SKUConflict{Item: i, SKU: prod.SKU, ExistingID: id.Hex()})
+			continue
+		}
+		ids = append(ids, db.insert(prod))
+	}
+
+	if len(dup) > 0 {
+		return ids, &ErrDuplicateSKU{dup}
+	}
+	return ids, nil
+}
+
+// UpdateProduct replaces the fields of the product with the given id and
+// returns its new version.
+// If the stored version is not version this function returns a
+// VersionMismatch error, if a product with the given id does not exist
+// it returns a ProductNotFound error
+func (db *MemoryProductsDB) UpdateProduct(ctx context.Context, p *Product, id primitive.ObjectID, version int64) (int64, error) {
+	return db.PatchProduct(ctx, p, productFields, id, version)
+}
+
+// PatchProduct copies the named fields of p onto the stored product
+func (db *MemoryProductsDB) PatchProduct(ctx context.Context, p *Product, fields []string, id primitive.ObjectID, version int64) (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	cur, ok := db.products[id]
+	if !ok || cur.DeletedAt != nil {
+		return 0, ErrProductNotFound
+	}
+	if cur.Version != version {
+		return 0, ErrVersionMismatch
+	}
+
+	np := *cur
+	for _, f := range fields {
+		np.setField(f, p)
+	}
+	if other, ok := db.skus[np.SKU]; ok && other != id {
+		return 0, &ErrDuplicateSKU{[]SKUConflict{{SKU: np.SKU, ExistingID: other.Hex()}}}
+	}
+	np.Version++
+	db.products[id] = &np
+	db.index.add(id, &np)
+	delete(db.skus, cur.SKU)
+	db.skus[np.SKU] = id
+
+	return np.Version, nil
+}
+
+// DeleteProduct moves the product with the given id to the trash
+func (db *MemoryProductsDB) DeleteProduct(ctx context.Context, id primitive.ObjectID, by string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	cur, ok := db.products[id]
+	if !ok || cur.DeletedAt != nil {
+		return ErrProductNotFound
+	}
+
+	now := time.Now().UTC()
+	np := *cur
+	np.DeletedAt = &now
+	np.DeletedBy = by
+	np.Version++
+	db.products[id] = &np
+	db.index.remove(id)
+	delete(db.skus, cur.SKU)
+
+	return nil
+}
+
+// GetDeletedProducts returns copies of the products in the trash, most recently deleted first
+func (db *MemoryProductsDB) GetDeletedProducts(ctx context.Context) (Products, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	results := Products{}
+	for _, id := range db.order {
+		if p := db.products[id]; p.DeletedAt != nil {
+			np := *p
+			results = append(results, &np)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].DeletedAt.After(*results[j].DeletedAt)
+	})
+
+	return results, nil
+}
+
+// RestoreProduct takes the product with the given id out of the trash, it
+// returns an ErrDuplicateSKU when another product has taken its SKU
+func (db *MemoryProductsDB) RestoreProduct(ctx context.Context, id primitive.ObjectID) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	cur, ok := db.products[id]
+	if !ok || cur.DeletedAt == nil {
+		return ErrProductNotFound
+	}
+
+	if other, ok := db.skus[cur.SKU]; ok {
+		return &ErrDuplicateSKU{[]SKUConflict{{SKU: cur.SKU, ExistingID: other.Hex()}}}
+	}
+
+	np := *cur
+	np.DeletedAt = nil
+	np.DeletedBy = ""
+	np.Version++
+	db.products[id] = &np
+	db.index.add(id, &np)
+	db.skus[np.SKU] = id
+
+	return nil
+}
+
+// PurgeDeletedProducts removes the products which were moved to the trash
+// before the given time and returns how many were removed
+func (db *MemoryProductsDB) PurgeDeletedProducts(ctx context.Context, before time.Time) (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var n int64
+	order := db.order[:0]
+	for _, id := range db.order {
+		if p := db.products[id]; p.DeletedAt != nil && p.DeletedAt.Before(before) {
+			delete(db.products, id)
+			n++
+			continue
+		}
+		order = append(order, id)
+	}
+	db.order = order
+
+	return n, nil
+}
+
+// SearchProducts returns copies of the products whose name or description
+// match text, ranked by the in-memory search index
+func (db *MemoryProductsDB) SearchProducts(ctx context.Context, text string, limit int, currency string) (Products, error) {
+	db.mu.RLock()
+	results := Products{}
+	for _, id := range db.index.search(text, ProductQuery{Limit: limit}.limit()) {
+		np := *db.products[id]
+		results = append(results, &np)
+	}
+	db.mu.RUnlock()
+
+	// a failed conversion still returns the products in their stored currency
+	err := db.convertPrices(ctx, results, currency)
+	return results, err
+}
+
+// ExportProducts calls fn with a copy of every product which is not in the
+// trash, iteration stops at the first error from fn
+func (db *MemoryProductsDB) ExportProducts(ctx context.Context, fn func(p *Product) error) error {
+	db.mu.RLock()
+	all := Products{}
+	for _, id := range db.order {
+		if p := db.products[id]; p.DeletedAt == nil {
+			np := *p
+			all = append(all, &np)
+		}
+	}
+	db.mu.RUnlock()
+
+	for _, p := range all {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+func TestMemoryProductsDB(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+
+	lp, err := db.GetProducts(ctx, ProductQuery{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp.Items) != len(ProductList) {
+		t.Fatalf("expected %d seeded products, got %d", len(ProductList), len(lp.Items))
+	}
+
+	ids, err := db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+
+	v, err := db.UpdateProduct(ctx, &Product{Name: "Mocha", Price: MustMoney("3.5", "EUR"), SKU: "abc-def-ghi"}, id, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Fatalf("expected version 2 after the update, got %d", v)
+	}
+
+	// a second writer still holding version 1 must not overwrite the change
+	_, err = db.UpdateProduct(ctx, &Product{Name: "Mocha", Price: MustMoney("9", "EUR"), SKU: "abc-def-ghi"}, id, 1)
+	if err != ErrVersionMismatch {
+		t.Fatalf("expected ErrVersionMismatch, got %v", err)
+	}
+
+	p, err := db.GetProductByID(ctx, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Price.String() != "3.50" {
+		t.Fatalf("expected updated price 3.5, got %v", p.Price)
+	}
+
+	// mutating a returned product must not change the store
+	p.Price = MustMoney("100", "EUR")
+	p, _ = db.GetProductByID(ctx, id, "")
+	if p.Price.String() != "3.50" {
+		t.Fatalf("store was mutated through a returned product")
+	}
+
+	if err := db.DeleteProduct(ctx, id, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetProductByID(ctx, id, ""); err != ErrProductNotFound {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestMemoryProductsDBPaging(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	db.AddProduct(ctx, []*Product{
+		{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"},
+		{Name: "Cortado", Price: MustMoney("2.45", "EUR"), SKU: "abc-def-jkl"},
+		{Name: "Flat White", Price: MustMoney("2.8", "EUR"), SKU: "abc-def-mno"},
+	})
+
+	q := ProductQuery{Limit: 2, Sort: "-price", MinPrice: "2"}
+	var names []string
+	for {
+		lp, err := db.GetProducts(ctx, q, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range lp.Items {
+			names = append(names, p.Name)
+		}
+		if lp.NextCursor == "" {
+			break
+		}
+		q.Cursor = lp.NextCursor
+	}
+
+	want := []string{"Mocha", "Flat White", "Latte", "Cortado"}
+	if len(names) != len(want) {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+	for i := range want {
+		// Latte and Cortado share a price so only the price order is asserted
+		if i < 2 && names[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, names)
+		}
+	}
+
+	q.Sort = "price"
+	if _, err := db.GetProducts(ctx, q, ""); err != ErrInvalidCursor {
+		t.Fatalf("expected ErrInvalidCursor for a cursor from another sort, got %v", err)
+	}
+}
+
+func TestMemoryProductsDBPatch(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Mocha", Description: "chocolate", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+
+	cur, _ := db.GetProductByID(ctx, id, "")
+	np := *cur
+	np.Price = MustMoney("3.5", "EUR")
+	np.Name = "ignored"
+
+	fields := ChangedFields(cur, &np)
+	if len(fields) != 2 || fields[0] != "name" || fields[1] != "price" {
+		t.Fatalf("expected name and price to change, got %v", fields)
+	}
+
+	// only the listed fields are written
+	v, err := db.PatchProduct(ctx, &np, []string{"price"}, id, cur.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := db.GetProductByID(ctx, id, "")
+	if p.Version != v || p.Name != "Mocha" || p.Description != "chocolate" || p.Price.String() != "3.50" {
+		t.Fatalf("unexpected product after patch %+v", p)
+	}
+
+	if _, err := db.PatchProduct(ctx, &np, []string{"price"}, id, cur.Version); err != ErrVersionMismatch {
+		t.Fatalf("expected ErrVersionMismatch, got %v", err)
+	}
+}
+
+func TestMemoryProductsDBDuplicateSKU(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+
+	ids, err := db.AddProduct(ctx, []*Product{
+		{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"},
+		{Name: "Cortado", Price: MustMoney("2.45", "EUR"), SKU: "abc-def-ghi"},
+		{Name: "Flat White", Price: MustMoney("2.8", "EUR"), SKU: "abc-def-mno"},
+	})
+	dup, ok := err.(*ErrDuplicateSKU)
+	if !ok {
+		t.Fatalf("expected ErrDuplicateSKU, got %v", err)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected the two products without conflicts to be added, got %v", ids)
+	}
+	if len(dup.Conflicts) != 1 || dup.Conflicts[0].Item != 1 || dup.Conflicts[0].ExistingID != ids[0] {
+		t.Fatalf("unexpected conflicts %+v", dup.Conflicts)
+	}
+
+	id, _ := primitive.ObjectIDFromHex(ids[1])
+	_, err = db.UpdateProduct(ctx, &Product{Name: "Flat White", Price: MustMoney("2.8", "EUR"), SKU: "abc-def-ghi"}, id, 1)
+	if dup, ok := err.(*ErrDuplicateSKU); !ok || dup.Conflicts[0].ExistingID != ids[0] {
+		t.Fatalf("expected ErrDuplicateSKU for the update, got %v", err)
+	}
+
+	// the SKU is released once the product is renamed
+	if _, err := db.UpdateProduct(ctx, &Product{Name: "Flat White", Price: MustMoney("2.8", "EUR"), SKU: "abc-def-pqr"}, id, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.AddProduct(ctx, []*Product{{Name: "Ristretto", Price: MustMoney("2", "EUR"), SKU: "abc-def-mno"}}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryProductsDBPriceQueryCurrency(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	seeded, _ := db.GetProducts(ctx, ProductQuery{}, "")
+	for _, p := range seeded.Items {
+		id, _ := primitive.ObjectIDFromHex(p.ID)
+		db.DeleteProduct(ctx, id, "test")
+	}
+	db.AddProduct(ctx, []*Product{
+		{Name: "Matcha", Price: MustMoney("100", "JPY"), SKU: "abc-def-ghi"},
+		{Name: "Scone", Price: MustMoney("50", "GBP"), SKU: "abc-def-jkl"},
+		{Name: "Tea", Price: MustMoney("1.50", "GBP"), SKU: "abc-def-mno"},
+		{Name: "Latte", Price: MustMoney("2.45", "EUR"), SKU: "abc-def-pqr"},
+	})
+
+	// the price sort keeps every product and groups them by currency, paging
+	// through the groups
+	q := ProductQuery{Sort: "-price", Limit: 1}
+	var names []string
+	for {
+		lp, err := db.GetProducts(ctx, q, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range lp.Items {
+			names = append(names, p.Name)
+		}
+		if lp.NextCursor == "" {
+			break
+		}
+		q.Cursor = lp.NextCursor
+	}
+	if strings.Join(names, ",") != "Matcha,Scone,Tea,Latte" {
+		t.Fatalf("expected JPY, GBP then EUR, each by descending amount, got %v", names)
+	}
+
+	lp, err := db.GetProducts(ctx, ProductQuery{Sort: "-price", PriceCurrency: "GBP"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp.Items) != 2 || lp.Items[0].Name != "Scone" || lp.Items[1].Name != "Tea" {
+		t.Fatalf("expected only the GBP products, Scone then Tea, got %v", lp.Items)
+	}
+
+	lp, err = db.GetProducts(ctx, ProductQuery{MinPrice: "60", PriceCurrency: "JPY"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp.Items) != 1 || lp.Items[0].Name != "Matcha" {
+		t.Fatalf("expected Matcha, got %v", lp.Items)
+	}
+
+	// the amounts of the price filters are EUR unless priceCurrency is set
+	lp, err = db.GetProducts(ctx, ProductQuery{MaxPrice: "10"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp.Items) != 1 || lp.Items[0].Name != "Latte" {
+		t.Fatalf("expected Latte, got %v", lp.Items)
+	}
+}
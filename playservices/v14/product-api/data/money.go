@@ -0,0 +1,266 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DefaultCurrency is the currency of prices which do not name one
+const DefaultCurrency = "EUR"
+
+// currencyExponents lists the ISO 4217 currencies whose minor unit is not
+// a hundredth, every other currency has two decimals
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"BIF": 0,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"RWF": 0,
+	"TND": 3,
+	"UGX": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XOF": 0,
+	"XPF": 0,
+}
+
+var amountRe = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// MinorUnits returns the number of decimals used by currency
+func MinorUnits(currency string) int {
+	if e, ok := currencyExponents[currency]; ok {
+		return e
+	}
+	return 2
+}
+
+// Money is an exact amount of a currency. The amount is held in the minor
+// unit of the currency, so 2.45 EUR is stored as 245 cents, which avoids the
+// rounding noise of binary floating point
+type Money struct {
+	// Minor is the amount in the minor unit of Currency
+	Minor int64
+	// Currency is the ISO 4217 code
+	Currency string
+}
+
+// NewMoney parses a decimal amount such as "2.45" in the given currency.
+// The amount can not have more decimals than the currency allows
+func NewMoney(amount string, currency string) (Money, error) {
+	r, err := parseAmount(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return moneyFromRat(r, currency)
+}
+
+// moneyFromRat returns the amount r, given in major units, as Money
+func moneyFromRat(r *big.Rat, currency string) (Money, error) {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	minor := new(big.Rat).Mul(r, pow10(MinorUnits(currency)))
+	if !minor.IsInt() {
+		return Money{}, fmt.Errorf("amount %s has more than %d decimals for %s", r.FloatString(10), MinorUnits(currency), currency)
+	}
+	if !minor.Num().IsInt64() {
+		return Money{}, fmt.Errorf("amount %s is too large", r.FloatString(0))
+	}
+
+	return Money{minor.Num().Int64(), currency}, nil
+}
+
+// MustMoney is like NewMoney but panics on error, it is meant for literals
+func MustMoney(amount string, currency string) Money {
+	m, err := NewMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func parseAmount(amount string) (*big.Rat, error) {
+	if !amountRe.MatchString(amount) {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	r, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	return r, nil
+}
+
+// pow10 returns 10^n as a rational, n can be negative
+func pow10(n int) *big.Rat {
+	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n))), nil)
+	if n < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), p)
+	}
+	return new(big.Rat).SetInt(p)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// roundRat rounds r to the nearest integer, halves are rounded away from zero
+func roundRat(r *big.Rat) int64 {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	m.Abs(m).Lsh(m, 1)
+	if m.Cmp(r.Denom()) >= 0 {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q.Int64()
+}
+
+// Rat returns the amount in major units, 2.45 for 245 cents
+func (m Money) Rat() *big.Rat {
+	r := new(big.Rat).SetInt64(m.Minor)
+	return r.Mul(r, pow10(-MinorUnits(m.Currency)))
+}
+
+// String returns the amount as a decimal with the decimals of the currency
+func (m Money) String() string {
+	return m.Rat().FloatString(MinorUnits(m.Currency))
+}
+
+// ErrCurrencyMismatch is returned when comparing amounts in different currencies
+var ErrCurrencyMismatch = fmt.Errorf("amounts are in different currencies")
+
+// Cmp compares the amounts of m and o, amounts in different currencies can
+// not be compared and return ErrCurrencyMismatch
+func (m Money) Cmp(o Money) (int, error) {
+	if m.Currency != o.Currency {
+		return 0, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return m.Rat().Cmp(o.Rat()), nil
+}
+
+// Convert returns m in the currency to using the given exchange rate. The
+// result is rounded to the minor unit of to, so JPY has no decimals
+func (m Money) Convert(rate float64, to string) Money {
+	// the shortest decimal which round trips to rate is what the currency
+	// service meant, using it avoids carrying binary noise into the amount
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(rate, 'g', -1, 64))
+
+	amount := m.Rat()
+	amount.Mul(amount, r)
+	amount.Mul(amount, pow10(MinorUnits(to)))
+
+	return Money{roundRat(amount), to}
+}
+
+// Decimal128 returns the amount as a BSON decimal
+func (m Money) Decimal128() primitive.Decimal128 {
+	d, _ := primitive.ParseDecimal128(m.String())
+	return d
+}
+
+// jsonMoney is the wire format of Money, the amount is a string so that
+// clients do not parse it into a float
+type jsonMoney struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON writes m as {"amount": "2.45", "currency": "EUR"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.String(), m.Currency})
+}
+
+// UnmarshalJSON reads the format written by MarshalJSON, a JSON number is
+// also accepted for the amount
+func (m *Money) UnmarshalJSON(b []byte) error {
+	var jm jsonMoney
+	if err := json.Unmarshal(b, &jm); err != nil {
+		return err
+	}
+
+	nm, err := NewMoney(strings.Trim(string(jm.Amount), `"`), jm.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = nm
+	return nil
+}
+
+// bsonMoney is the storage format of Money
+type bsonMoney struct {
+	Amount   primitive.Decimal128 `bson:"amount"`
+	Currency string               `bson:"currency"`
+}
+
+// MarshalBSONValue stores m as {amount: Decimal128, currency: string}
+func (m Money) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(bsonMoney{m.Decimal128(), m.Currency})
+}
+
+// UnmarshalBSONValue reads the format written by MarshalBSONValue. Prices
+// stored before Money existed are EUR doubles and are rounded to cents
+func (m *Money) UnmarshalBSONValue(t bsontype.Type, b []byte) error {
+	switch t {
+	case bson.TypeDouble:
+		f, ok := bson.RawValue{Type: t, Value: b}.DoubleOK()
+		if !ok {
+			return fmt.Errorf("invalid price")
+		}
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+		*m = Money{roundRat(r.Mul(r, pow10(MinorUnits(DefaultCurrency)))), DefaultCurrency}
+		return nil
+
+	case bson.TypeEmbeddedDocument:
+		var bm bsonMoney
+		if err := bson.Unmarshal(b, &bm); err != nil {
+			return err
+		}
+		bi, exp, err := bm.Amount.BigInt()
+		if err != nil {
+			return err
+		}
+		r := new(big.Rat).SetInt(bi)
+		nm, err := moneyFromRat(r.Mul(r, pow10(exp)), bm.Currency)
+		if err != nil {
+			return err
+		}
+		*m = nm
+		return nil
+
+	default:
+		return fmt.Errorf("cannot decode %s into a price", t)
+	}
+}
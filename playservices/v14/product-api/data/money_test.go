@@ -0,0 +1,93 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMoneyConvert(t *testing.T) {
+	tt := []struct {
+		price Money
+		rate  float64
+		to    string
+		want  string
+	}{
+		// 2.45 * 1.1 is 2.6950000000000003 in float64
+		{MustMoney("2.45", "EUR"), 1.1, "USD", "2.70"},
+		{MustMoney("1.99", "EUR"), 162.57, "JPY", "324"},
+		{MustMoney("0.10", "EUR"), 0.3, "GBP", "0.03"},
+		{MustMoney("300", "JPY"), 0.00615, "EUR", "1.85"},
+	}
+
+	for _, tc := range tt {
+		got := tc.price.Convert(tc.rate, tc.to)
+		if got.String() != tc.want || got.Currency != tc.to {
+			t.Errorf("%s %s * %v: expected %s %s, got %s %s", tc.price, tc.price.Currency, tc.rate, tc.want, tc.to, got, got.Currency)
+		}
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	b, err := json.Marshal(MustMoney("2.45", "EUR"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount":"2.45","currency":"EUR"}` {
+		t.Fatalf("unexpected json %s", b)
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`{"amount":3,"currency":"JPY"}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m != MustMoney("3", "JPY") {
+		t.Fatalf("unexpected money %v", m)
+	}
+
+	if err := json.Unmarshal([]byte(`{"amount":"2.455","currency":"EUR"}`), &m); err == nil {
+		t.Fatal("expected an error for more decimals than the currency has")
+	}
+}
+
+func TestMoneyBSON(t *testing.T) {
+	p := Product{Name: "Latte", Price: MustMoney("2.45", "EUR")}
+	b, err := bson.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw bson.M
+	bson.Unmarshal(b, &raw)
+	if d := raw["price"].(bson.M)["amount"]; d.(interface{ String() string }).String() != "2.45" {
+		t.Fatalf("expected a Decimal128 amount, got %v", d)
+	}
+
+	var np Product
+	if err := bson.Unmarshal(b, &np); err != nil {
+		t.Fatal(err)
+	}
+	if np.Price != p.Price {
+		t.Fatalf("expected %v, got %v", p.Price, np.Price)
+	}
+
+	// documents written before Money stored the price as a EUR double
+	b, _ = bson.Marshal(bson.M{"name": "Latte", "price": 2.45})
+	if err := bson.Unmarshal(b, &np); err != nil {
+		t.Fatal(err)
+	}
+	if np.Price != p.Price {
+		t.Fatalf("expected %v, got %v", p.Price, np.Price)
+	}
+}
+
+func TestMoneyCmp(t *testing.T) {
+	if c, err := MustMoney("2.45", "EUR").Cmp(MustMoney("2.5", "EUR")); err != nil || c != -1 {
+		t.Fatalf("expected 2.45 EUR to be less than 2.50 EUR, got %v %v", c, err)
+	}
+	if _, err := MustMoney("100", "JPY").Cmp(MustMoney("50", "GBP")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
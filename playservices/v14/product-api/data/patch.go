@@ -0,0 +1,54 @@
+package data
+
+// productFields are the stored names of the fields a client can change,
+// everything else is owned by the store
+var productFields = []string{"name", "description", "price", "sku"}
+
+// ChangedFields returns the stored names of the client editable fields
+// which differ between a and b, in the order of productFields
+func ChangedFields(a, b *Product) []string {
+	changed := []string{}
+	if a.Name != b.Name {
+		changed = append(changed, "name")
+	}
+	if a.Description != b.Description {
+		changed = append(changed, "description")
+	}
+	if a.Price != b.Price {
+		changed = append(changed, "price")
+	}
+	if a.SKU != b.SKU {
+		changed = append(changed, "sku")
+	}
+	return changed
+}
+
+// fieldValue returns the value of the named field of p, it is used to build
+// targeted updates from the names returned by ChangedFields
+func (p *Product) fieldValue(field string) interface{} {
+	switch field {
+	case "name":
+		return p.Name
+	case "description":
+		return p.Description
+	case "price":
+		return p.Price
+	case "sku":
+		return p.SKU
+	}
+	return nil
+}
+
+// setField copies the named field from src to p
+func (p *Product) setField(field string, src *Product) {
+	switch field {
+	case "name":
+		p.Name = src.Name
+	case "description":
+		p.Description = src.Description
+	case "price":
+		p.Price = src.Price
+	case "sku":
+		p.SKU = src.SKU
+	}
+}
@@ -2,8 +2,9 @@ package data
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"regexp"
 	"time"
 
 	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
@@ -12,13 +13,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
 )
 
 var ErrProductNotFound = fmt.Errorf("product not found")
+var ErrVersionMismatch = fmt.Errorf("product has been modified since it was read")
 
 // Product defines the structure for an API product
 type Product struct {
@@ -37,255 +35,543 @@ type Product struct {
 	// required: false
 	// max length: 10000
 	Description string `json:"description" bson:"description,omitempty"`
-	// the price for the product
+	// the price for the product, the amount is a decimal string and the
+	// currency is the base currency prices are converted from
 	//
 	// required: true
-	// min: 0.01
-	Price float64 `json:"price" bson:"price" validate:"gt=0"`
+	Price Money `json:"price" bson:"price" validate:"money"`
 	// the SKU for the product
 	//
 	// required: true
 	// pattern: [a-z]+-[a-z]+-[a-z]+
 	SKU string `json:"sku" bson:"sku" validate:"required,sku"`
+	// the version of the product, it is incremented on every update
+	// and must be sent back in the If-Match header of a PUT
+	//
+	// required: false
+	// read only: true
+	Version int64 `json:"version" bson:"version"`
+	// when the product was moved to the trash, deleted products are
+	// hidden from every read except the trash listing
+	//
+	// required: false
+	// read only: true
+	DeletedAt *time.Time `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"`
+	// who moved the product to the trash
+	//
+	// required: false
+	// read only: true
+	DeletedBy string `json:"deletedBy,omitempty" bson:"deletedBy,omitempty"`
+	// the price converted into each currency asked for with the currencies
+	// parameter, keyed by ISO 4217 code
+	//
+	// required: false
+	// read only: true
+	Prices map[string]Money `json:"prices,omitempty" bson:"-"`
 }
 
+// notDeleted and deleted select the products outside and inside the trash
+var (
+	notDeleted = bson.E{Key: "deletedAt", Value: nil}
+	deleted    = bson.E{Key: "deletedAt", Value: bson.D{{Key: "$ne", Value: nil}}}
+)
+
 // Products is a collection of Product
 type Products []*Product
 
+// ProductStore is the interface the handlers use to read and write products.
+// ProductsDB is backed by MongoDB, MemoryProductsDB keeps everything in process.
+// The methods taking a currency return ErrConversionUnavailable together with
+// the products when their prices could not be converted, and
+// ErrUnknownCurrency when a currency does not exist. The optional
+// currencies fill in Product.Prices next to the price
+type ProductStore interface {
+	GetProducts(ctx context.Context, q ProductQuery, currency string, currencies ...string) (*ProductPage, error)
+	GetProductByID(ctx context.Context, id primitive.ObjectID, currency string, currencies ...string) (*Product, error)
+	AddProduct(ctx context.Context, p []*Product) ([]string, error)
+	UpdateProduct(ctx context.Context, p *Product, id primitive.ObjectID, version int64) (int64, error)
+	PatchProduct(ctx context.Context, p *Product, fields []string, id primitive.ObjectID, version int64) (int64, error)
+	DeleteProduct(ctx context.Context, id primitive.ObjectID, by string) error
+	GetDeletedProducts(ctx context.Context) (Products, error)
+	RestoreProduct(ctx context.Context, id primitive.ObjectID) error
+	PurgeDeletedProducts(ctx context.Context, before time.Time) (int64, error)
+	SearchProducts(ctx context.Context, text string, limit int, currency string) (Products, error)
+	ExportProducts(ctx context.Context, fn func(p *Product) error) error
+}
+
+// ProductsDB is a ProductStore backed by a MongoDB collection
 type ProductsDB struct {
-	currencyClient  protos.CurrencyClient
-	l               *zap.Logger
-	rates           map[string]float64
-	currSubClient   protos.Currency_SubscribeRatesClient
+	*rateConverter
 	mongoClient     *mongo.Client
 	mongoCollection *mongo.Collection
 }
 
 func GetProductsDB(c protos.CurrencyClient, l *zap.Logger, mc *mongo.Client) *ProductsDB {
-	db := &ProductsDB{c, l, make(map[string]float64), nil, nil, nil}
-
-	go db.handleUpdates()
-
-	return db
+	return &ProductsDB{getRateConverter(c, l), mc, nil}
 }
 
-func (db *ProductsDB) handleUpdates() {
-	// Recv returns a StreamingRateResponse which can contain one of two messages
-	// RateResponse or an Error.
-	// We need to handle each case separately
-	subClient, err := db.currencyClient.SubscribeRates(context.Background())
+// GetProducts returns a page of products from the database
+func (db *ProductsDB) GetProducts(ctx context.Context, q ProductQuery, currency string, currencies ...string) (*ProductPage, error) {
 
-	if err != nil {
-		// handle connection errors
-		// this is normally terminal requires a reconnect
-		db.l.Error("unable to subscribe for rates", zap.Error(err))
-		for retries := 0; retries < 5; retries++ {
-			subClient, err = db.currencyClient.SubscribeRates(context.Background())
-			if err == nil {
-				break
-			}
-			time.Sleep(5 * time.Second)
-			db.l.Error("Retrying to connect to grpc-server...")
-		}
-		if err != nil {
-			return
-		}
+	if err := db.mongoClient.Ping(ctx, nil); err != nil {
+		db.l.Error("mongoClient is not connected", zap.Error(err))
+		return nil, fmt.Errorf("client is disconnected: %v", err)
 	}
 
+	filter, err := q.mongoFilter()
 	if err != nil {
-		db.l.Error("unable to subscribe for rates", zap.Error(err))
-		return
-	}
-
-	db.currSubClient = subClient
-
-	for {
-		// Recv returns a StreamingRateResponse which can contain one of two messages
-		// RateResponse or an Error.
-		// We need to handle each case separately
-		sresp, err := db.currSubClient.Recv()
-
-		// handle connection errors
-		// this is normally terminal requires a reconnect
-		if err != nil {
-			db.l.Error("error receiving message", zap.Error(err))
-			return
-		}
-
-		// handle a returned error message
-		if ss := sresp.GetError(); ss != nil {
-			db.l.Error("error subscribing for rates", zap.Any("error", ss))
-			sre := status.FromProto(ss)
-			if sre.Code() == codes.InvalidArgument {
-				errDetails := ""
-				// get the RateRequest serialized in the error response
-				// Details is a collection but we are only returning a single item
-				if d := sre.Details(); len(d) > 0 {
-					db.l.Error("", zap.Any("details", d))
-					if rr, ok := d[0].(*protos.RateRequest); ok {
-						errDetails = fmt.Sprintf("base: %s destination: %s", rr.GetBase().String(), rr.GetDestination().String())
-					}
-				}
-				db.l.Error("error receiving message", zap.Any("", errDetails))
-			}
-		}
-
-		// handle a rate response
-		if rresp := sresp.GetRateResponse(); rresp != nil {
-			db.l.Info("received updated rate from server", zap.Any("destination", rresp.Destination.String()))
-			db.rates[rresp.Destination.String()] = rresp.Rate
-		}
+		return nil, err
 	}
-}
-
-// GetProducts returns all products from the database
-func (db *ProductsDB) GetProducts(ctx context.Context, currency string) (Products, error) {
 
-	db.l.Info("here")
-	if err := db.mongoClient.Ping(ctx, nil); err != nil {
-		db.l.Error("mongoClient is not connected", zap.Error(err))
-		return nil, fmt.Errorf("client is disconnected: %v", err)
-	}
+	// fetch one extra document to find out if there is a next page
+	limit := q.limit()
+	opts := options.Find().SetSort(q.mongoSort()).SetLimit(int64(limit + 1))
 
-	filter := bson.M{}
-	cursor, err := db.mongoCollection.Find(ctx, filter)
+	cursor, err := db.mongoCollection.Find(ctx, filter, opts)
 	if err != nil {
 		db.l.Error("error finding data", zap.Error(err))
+		return nil, err
 	}
-	var results []*Product
+	results := Products{}
 
 	if err = cursor.All(ctx, &results); err != nil {
 		db.l.Error("error decoding data", zap.Error(err))
-	}
-	if currency == "" {
-		return results, nil
-	}
-	db.l.Info("here", zap.Any("results: ", results))
-	r, err := db.getRate(currency)
-	if err != nil {
-		db.l.Error("[ERROR] unable to get rate", zap.Any("currency", currency), zap.Error(err))
 		return nil, err
 	}
 
-	for _, v := range results {
-		v.Price = v.Price * r
+	page := &ProductPage{Items: results}
+	if len(results) > limit {
+		page.Items = results[:limit]
+		page.NextCursor = q.encodeCursor(page.Items[limit-1])
 	}
 
-	/* 	products := Products{}
-	   	for _, p := range productList {
-	   		np := *p
-	   		np.Price = np.Price * r
-	   		products = append(products, &np)
-	   	}
-	*/
-	return results, nil
+	// a failed conversion still returns the products in their stored currency
+	err = db.convertPrices(ctx, page.Items, currency, currencies...)
+	return page, err
 }
 
 // GetProductByID returns a single product which matches the id from the
 // database.
 // If a product is not found this function returns a ProductNotFound error
-func (db *ProductsDB) GetProductByID(ctx context.Context, id primitive.ObjectID, currency string) (*Product, error) {
+func (db *ProductsDB) GetProductByID(ctx context.Context, id primitive.ObjectID, currency string, currencies ...string) (*Product, error) {
 
 	filter := bson.D{
 		{
 			Key:   "_id",
 			Value: id,
 		},
+		notDeleted,
 	}
 	p := new(Product)
 	err := db.mongoCollection.FindOne(ctx, filter).Decode(p)
+	if err == mongo.ErrNoDocuments {
+		return nil, ErrProductNotFound
+	}
 	if err != nil {
 		db.l.Error("[ERROR] unable to find the product", zap.Error(err))
 		return nil, err
 	}
 
-	if currency == "" {
-		return p, nil
+	// a failed conversion still returns the products in their stored currency
+	err = db.convertPrices(ctx, Products{p}, currency, currencies...)
+	return p, err
+}
+
+// AddProduct adds new products to the database and returns their ids
+func (db *ProductsDB) AddProduct(ctx context.Context, p []*Product) ([]string, error) {
+
+	var docs []interface{}
+	for _, prod := range p {
+		// ids are assigned by the database and new products are never in the trash
+		np := *prod
+		np.ID = ""
+		np.Version = 1
+		np.DeletedAt = nil
+		np.DeletedBy = ""
+		docs = append(docs, &np)
 	}
 
-	r, err := db.getRate(currency)
-	if err != nil {
-		db.l.Error("[ERROR] unable to get rate", zap.Any("currency", currency), zap.Error(err))
-		return nil, err
+	// unordered so that one duplicate SKU does not stop the rest of the batch
+	res, err := db.mongoCollection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	var bwe mongo.BulkWriteException
+	if err != nil && !errors.As(err, &bwe) {
+		return nil, fmt.Errorf("error inserting records: %v", err)
+	}
+
+	failed := map[int]bool{}
+	var dup []SKUConflict
+	for _, we := range bwe.WriteErrors {
+		failed[we.Index] = true
+		if we.HasErrorCode(duplicateKeyCode) {
+			dup = append(dup, SKUConflict{Item: we.Index, SKU: p[we.Index].SKU})
+		}
+	}
+	if len(failed) > len(dup) || bwe.WriteConcernError != nil {
+		return nil, fmt.Errorf("error inserting records: %v", err)
 	}
 
-	p.Price = p.Price * r
-	return p, nil
+	var ids []string
+	for i, id := range res.InsertedIDs {
+		if oid, ok := id.(primitive.ObjectID); ok && !failed[i] {
+			ids = append(ids, oid.Hex())
+		}
+	}
+
+	if len(dup) > 0 {
+		return ids, db.skuConflicts(ctx, dup)
+	}
+	return ids, nil
 }
 
-// AddProduct adds a new product to the database
-func (db *ProductsDB) AddProduct(ctx context.Context, d []interface{}) (*mongo.InsertManyResult, error) {
+// duplicateKeyCode is the server error code for a unique index violation
+const duplicateKeyCode = 11000
+
+// skuConflicts fills in the ids of the products which already have the
+// SKUs of the conflicts and returns them as an ErrDuplicateSKU
+func (db *ProductsDB) skuConflicts(ctx context.Context, conflicts []SKUConflict) error {
+	skus := bson.A{}
+	for _, c := range conflicts {
+		skus = append(skus, c.SKU)
+	}
 
-	res, err := db.mongoCollection.InsertMany(ctx, d)
+	filter := bson.D{{Key: "sku", Value: bson.D{{Key: "$in", Value: skus}}}, notDeleted}
+	opts := options.Find().SetProjection(bson.D{{Key: "sku", Value: 1}})
+	cursor, err := db.mongoCollection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error inserting records: %v", err)
+		db.l.Error("error looking up duplicate skus", zap.Error(err))
+		return err
 	}
-	return res, nil
+
+	var existing Products
+	if err := cursor.All(ctx, &existing); err != nil {
+		return err
+	}
+
+	for i := range conflicts {
+		for _, e := range existing {
+			if e.SKU == conflicts[i].SKU {
+				conflicts[i].ExistingID = e.ID
+			}
+		}
+	}
+
+	return &ErrDuplicateSKU{conflicts}
 }
 
 // UpdateProduct replaces a product in the database with the given
-// item.
+// item and returns its new version.
+// The update only applies when the stored version still equals version,
+// otherwise this function returns a VersionMismatch error.
 // If a product with the given id does not exist in the database
 // this function returns a ProductNotFound error
-func (db *ProductsDB) UpdateProduct(ctx context.Context, p []*Product, id primitive.ObjectID) (*mongo.BulkWriteResult, error) {
+func (db *ProductsDB) UpdateProduct(ctx context.Context, p *Product, id primitive.ObjectID, version int64) (int64, error) {
+	return db.PatchProduct(ctx, p, productFields, id, version)
+}
+
+// PatchProduct writes only the named fields of p, as returned by
+// ChangedFields, to the product with the given id and version
+func (db *ProductsDB) PatchProduct(ctx context.Context, p *Product, fields []string, id primitive.ObjectID, version int64) (int64, error) {
 
-	var updateModels []mongo.WriteModel
 	filter := bson.D{
 		{
 			Key:   "_id",
 			Value: id,
 		},
+		{
+			Key:   "version",
+			Value: versionFilter(version),
+		},
+		notDeleted,
 	}
 
-	for _, prod := range p {
-		update := bson.D{
-			{Key: "$set", Value: bson.D{
-				{Key: "name", Value: prod.Name},
-				{Key: "description", Value: prod.Description},
-				{Key: "price", Value: prod.Price},
-				{Key: "sku", Value: prod.SKU},
-			}},
-		}
-		model := mongo.NewUpdateManyModel().SetFilter(filter).SetUpdate(update)
-		updateModels = append(updateModels, model)
+	set := bson.D{}
+	for _, f := range fields {
+		set = append(set, bson.E{Key: f, Value: p.fieldValue(f)})
 	}
 
-	res, err := db.mongoCollection.BulkWrite(ctx, updateModels)
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{
+			{Key: "version", Value: 1},
+		}},
+	}
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: set})
+	}
+
+	res, err := db.mongoCollection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return 0, db.skuConflicts(ctx, []SKUConflict{{SKU: p.SKU}})
+	}
 	if err != nil {
 		db.l.Error("error updating one product", zap.Error(err))
-		return nil, err
+		return 0, err
 	}
 
-	switch res.MatchedCount {
-	case 0:
-		return nil, ErrProductNotFound
-	default:
-		return res, nil
+	if res.MatchedCount == 0 {
+		// tell a missing product apart from a stale version
+		n, err := db.mongoCollection.CountDocuments(ctx, bson.D{{Key: "_id", Value: id}, notDeleted})
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return 0, ErrProductNotFound
+		}
+		return 0, ErrVersionMismatch
 	}
+
+	return version + 1, nil
 }
 
-// DeleteProduct deletes a product from the database
-func (db *ProductsDB) DeleteProduct(ctx context.Context, id primitive.ObjectID) error {
+// versionFilter matches the given version, documents written before the
+// version field existed have no version and are treated as version 0
+func versionFilter(version int64) interface{} {
+	if version == 0 {
+		return bson.D{{Key: "$in", Value: bson.A{0, nil}}}
+	}
+	return version
+}
+
+// DeleteProduct moves a product to the trash, it stays in the database
+// until it is restored or purged
+func (db *ProductsDB) DeleteProduct(ctx context.Context, id primitive.ObjectID, by string) error {
 
 	filter := bson.D{
 		{
 			Key:   "_id",
 			Value: id,
 		},
+		notDeleted,
 	}
-	res, err := db.mongoCollection.DeleteOne(ctx, filter)
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "deletedAt", Value: time.Now().UTC()},
+			{Key: "deletedBy", Value: by},
+		}},
+		{Key: "$inc", Value: bson.D{
+			{Key: "version", Value: 1},
+		}},
+	}
+	res, err := db.mongoCollection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		db.l.Error("error deleting product from database", zap.Error(err))
 		return err
 	}
 
-	if res.DeletedCount == 0 {
+	if res.MatchedCount == 0 {
+		return ErrProductNotFound
+	}
+
+	return nil
+}
+
+// GetDeletedProducts returns the products in the trash, most recently deleted first
+func (db *ProductsDB) GetDeletedProducts(ctx context.Context) (Products, error) {
+
+	opts := options.Find().SetSort(bson.D{{Key: "deletedAt", Value: -1}})
+	cursor, err := db.mongoCollection.Find(ctx, bson.D{deleted}, opts)
+	if err != nil {
+		db.l.Error("error finding deleted products", zap.Error(err))
+		return nil, err
+	}
+
+	results := Products{}
+	if err = cursor.All(ctx, &results); err != nil {
+		db.l.Error("error decoding data", zap.Error(err))
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// RestoreProduct takes a product out of the trash.
+// If the product is not in the trash this function returns a ProductNotFound error,
+// if another product has taken its SKU in the meantime it returns an ErrDuplicateSKU
+func (db *ProductsDB) RestoreProduct(ctx context.Context, id primitive.ObjectID) error {
+
+	filter := bson.D{
+		{
+			Key:   "_id",
+			Value: id,
+		},
+		deleted,
+	}
+	update := bson.D{
+		{Key: "$unset", Value: bson.D{
+			{Key: "deletedAt", Value: ""},
+			{Key: "deletedBy", Value: ""},
+		}},
+		{Key: "$inc", Value: bson.D{
+			{Key: "version", Value: 1},
+		}},
+	}
+	res, err := db.mongoCollection.UpdateOne(ctx, filter, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.restoreConflict(ctx, id)
+	}
+	if err != nil {
+		db.l.Error("error restoring product", zap.Error(err))
+		return err
+	}
+
+	if res.MatchedCount == 0 {
 		return ErrProductNotFound
 	}
 
 	return nil
 }
 
+// restoreConflict returns the ErrDuplicateSKU for the product with the
+// given id, whose SKU is used by a product outside the trash
+func (db *ProductsDB) restoreConflict(ctx context.Context, id primitive.ObjectID) error {
+	var p Product
+	err := db.mongoCollection.FindOne(ctx, bson.D{{Key: "_id", Value: id}}).Decode(&p)
+	if err != nil {
+		db.l.Error("error finding restored product", zap.Error(err))
+		return err
+	}
+
+	return db.skuConflicts(ctx, []SKUConflict{{SKU: p.SKU}})
+}
+
+// PurgeDeletedProducts removes the products which were moved to the trash
+// before the given time and returns how many were removed
+func (db *ProductsDB) PurgeDeletedProducts(ctx context.Context, before time.Time) (int64, error) {
+
+	filter := bson.D{{Key: "deletedAt", Value: bson.D{{Key: "$lt", Value: before}}}}
+	res, err := db.mongoCollection.DeleteMany(ctx, filter)
+	if err != nil {
+		db.l.Error("error purging deleted products", zap.Error(err))
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
+
+// SearchProducts returns the products whose name or description match text,
+// ranked by the text index score. The last word of text is also matched as
+// a prefix of the name so that partially typed queries autocomplete
+func (db *ProductsDB) SearchProducts(ctx context.Context, text string, limit int, currency string) (Products, error) {
+
+	limit = ProductQuery{Limit: limit}.limit()
+	score := bson.D{{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}}}
+	opts := options.Find().SetProjection(score).SetSort(score).SetLimit(int64(limit))
+
+	filter := bson.D{{Key: "$text", Value: bson.D{{Key: "$search", Value: text}}}, notDeleted}
+	cursor, err := db.mongoCollection.Find(ctx, filter, opts)
+	if err != nil {
+		db.l.Error("error searching products", zap.Error(err))
+		return nil, err
+	}
+	results := Products{}
+	if err = cursor.All(ctx, &results); err != nil {
+		db.l.Error("error decoding data", zap.Error(err))
+		return nil, err
+	}
+
+	// the text index only matches whole words, top up with name prefix matches
+	words := tokenize(text)
+	if len(results) < limit && len(words) > 0 {
+		var found bson.A
+		for _, p := range results {
+			if id, err := primitive.ObjectIDFromHex(p.ID); err == nil {
+				found = append(found, id)
+			}
+		}
+		prefix := primitive.Regex{Pattern: `\b` + regexp.QuoteMeta(words[len(words)-1]), Options: "i"}
+		filter := bson.D{{Key: "name", Value: prefix}, notDeleted}
+		if len(found) > 0 {
+			filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$nin", Value: found}}})
+		}
+
+		cursor, err := db.mongoCollection.Find(ctx, filter, options.Find().SetLimit(int64(limit-len(results))))
+		if err != nil {
+			db.l.Error("error searching products", zap.Error(err))
+			return nil, err
+		}
+		var prefixed Products
+		if err = cursor.All(ctx, &prefixed); err != nil {
+			db.l.Error("error decoding data", zap.Error(err))
+			return nil, err
+		}
+		results = append(results, prefixed...)
+	}
+
+	// a failed conversion still returns the products in their stored currency
+	err = db.convertPrices(ctx, results, currency)
+	return results, err
+}
+
+// ExportProducts calls fn for every product which is not in the trash.
+// The products are decoded from the cursor one at a time so the collection
+// is never held in memory, iteration stops at the first error from fn
+func (db *ProductsDB) ExportProducts(ctx context.Context, fn func(p *Product) error) error {
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := db.mongoCollection.Find(ctx, bson.D{notDeleted}, opts)
+	if err != nil {
+		db.l.Error("error exporting products", zap.Error(err))
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		p := &Product{}
+		if err := cursor.Decode(p); err != nil {
+			db.l.Error("error decoding data", zap.Error(err))
+			return err
+		}
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// EnsureIndexes creates the indexes used by the filters and sort orders of GetProducts
+func (db *ProductsDB) EnsureIndexes(ctx context.Context) error {
+	models := []mongo.IndexModel{
+		// the price sort orders by currency first and the price filters
+		// are restricted to a single currency
+		{Keys: bson.D{{Key: "price.currency", Value: 1}, {Key: "price.amount", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}},
+		// a SKU is unique among the products outside the trash. Partial indexes
+		// can not select on a missing field, so deletedAt is part of the key
+		// instead: it is null for every live product and the deletion time in
+		// the trash, which lets a deleted product keep a SKU that is reused
+		{Keys: bson.D{{Key: "sku", Value: 1}, {Key: "deletedAt", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "deletedAt", Value: 1}}, Options: options.Index().SetSparse(true)},
+		{
+			Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().SetName("product_text").
+				SetWeights(bson.D{{Key: "name", Value: nameWeight}, {Key: "description", Value: descriptionWeight}}),
+		},
+	}
+
+	_, err := db.mongoCollection.Indexes().CreateMany(ctx, models)
+	if err != nil {
+		db.l.Error("error creating indexes", zap.Error(err))
+		return err
+	}
+
+	// the index on sku alone, plain or unique, was replaced by the one above
+	// and would keep the SKUs in the trash from being reused
+	_, err = db.mongoCollection.Indexes().DropOne(ctx, "sku_1")
+	if err != nil && !isIndexNotFound(err) {
+		db.l.Error("error dropping the old sku index", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// isIndexNotFound reports whether err is caused by dropping an index which
+// does not exist
+func isIndexNotFound(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && (se.HasErrorCode(26) || se.HasErrorCode(27))
+}
+
 func (db *ProductsDB) DisconnectMongoClient() error {
 
 	return db.mongoClient.Disconnect(context.Background())
@@ -321,14 +607,16 @@ func (db *ProductsDB) MigrateDocs(ctx context.Context) (*mongo.InsertManyResult,
 		Product{
 			Name:        "Latte",
 			Description: "Frothy milky coffee",
-			Price:       2.45,
+			Price:       MustMoney("2.45", "EUR"),
 			SKU:         "abc323",
+			Version:     1,
 		},
 		Product{
 			Name:        "Espresso",
 			Description: "Short and strong coffee without milk",
-			Price:       1.99,
+			Price:       MustMoney("1.99", "EUR"),
 			SKU:         "fjd34",
+			Version:     1,
 		},
 	}
 
@@ -340,52 +628,6 @@ func (db *ProductsDB) MigrateDocs(ctx context.Context) (*mongo.InsertManyResult,
 	return result, nil
 }
 
-func (db *ProductsDB) getRate(destination string) (float64, error) {
-	// if cached return
-	/* 	if r, ok := db.rates[destination]; ok {
-		return r, nil
-	} */
-
-	req := &protos.RateRequest{
-		Base:        protos.Currencies(protos.Currencies_value["EUR"]),
-		Destination: protos.Currencies(protos.Currencies_value[destination]),
-	}
-
-	// get initial rate
-	resp, err := db.currencyClient.GetRate(context.Background(), req)
-	if err != nil {
-		if s, ok := status.FromError(err); ok {
-			md := s.Details()[0].(*protos.RateRequest)
-			if s.Code() == codes.InvalidArgument {
-				return -1, fmt.Errorf("base %v and destination currencies %v cannot be the same", md.Base.String(), md.Destination.String())
-			}
-			return -1, fmt.Errorf("unable to get rate from currency server for Base: %v, Destination: %v", md.Base.String(), md.Destination.String())
-		}
-	}
-	db.rates[destination] = resp.Rate
-
-	// subscribe for updates
-	db.currSubClient.Send(req)
-	if err != nil {
-		return -1, err
-	}
-
-	return resp.Rate, err
-}
-
-func GetgrpcClient(s string, l *zap.Logger) *grpc.ClientConn {
-
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	conn, err := grpc.NewClient(s, opts...)
-	if err != nil {
-		log.Fatalf("fail to dial: %v", err)
-	}
-	//defer conn.Close()
-
-	return conn
-}
-
 // productList is a hard coded list of products for this
 // example data source
 var productList = []*Product{
@@ -394,7 +636,7 @@ var productList = []*Product{
 		//ID:          "669d04756b448f4c1fef495e"
 		Name:        "Latte",
 		Description: "Frothy milky coffee",
-		Price:       2.45,
+		Price:       MustMoney("2.45", "EUR"),
 		SKU:         "abc323",
 	},
 	{
@@ -402,7 +644,7 @@ var productList = []*Product{
 		//ID:          "669d04756b448f4c1fef495f",
 		Name:        "Espresso",
 		Description: "Short and strong coffee without milk",
-		Price:       1.99,
+		Price:       MustMoney("1.99", "EUR"),
 		SKU:         "fjd34",
 	},
 }
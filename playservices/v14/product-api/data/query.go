@@ -0,0 +1,317 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// DefaultPageLimit is the page size used when the client does not ask for one
+	DefaultPageLimit = 50
+	// MaxPageLimit is the largest page a client can ask for
+	MaxPageLimit = 200
+)
+
+var ErrInvalidCursor = fmt.Errorf("invalid cursor")
+var ErrInvalidSort = fmt.Errorf("invalid sort, expected one of price, -price, name, -name")
+
+// ProductQuery describes which page of products to return and in which order.
+// Price filters and the price sort apply to the stored price, before any
+// currency conversion. Amounts in different currencies are never compared:
+// the price sort orders by currency and then by amount, and the price
+// filters only match the products priced in PriceCurrency
+type ProductQuery struct {
+	// Limit is the maximum number of products in the page
+	Limit int
+	// Cursor is the opaque nextCursor returned with the previous page
+	Cursor string
+	// Sort is a field name optionally prefixed with - for descending order
+	Sort string
+	// MinPrice and MaxPrice are decimal amounts, they are ignored when empty
+	MinPrice string
+	MaxPrice string
+	// SKU only returns the products with exactly this SKU
+	SKU string
+	// PriceCurrency only returns the products priced in this currency, it
+	// is the currency of MinPrice and MaxPrice and defaults to
+	// DefaultCurrency when they are set
+	PriceCurrency string
+}
+
+// ProductPage is a single page of products
+type ProductPage struct {
+	Items      Products `json:"items"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+// cursor is the decoded form of ProductPage.NextCursor, it remembers the sort
+// key and id of the last product on the page
+type cursor struct {
+	Sort     string `json:"s,omitempty"`
+	ID       string `json:"id"`
+	Price    string `json:"p,omitempty"`
+	Currency string `json:"c,omitempty"`
+	Name     string `json:"n,omitempty"`
+}
+
+// sortField returns the product field and the direction requested by q.Sort
+func (q ProductQuery) sortField() (string, bool, error) {
+	desc := strings.HasPrefix(q.Sort, "-")
+	f := strings.TrimPrefix(q.Sort, "-")
+
+	switch f {
+	case "", "price", "name":
+		return f, desc, nil
+	default:
+		return "", false, ErrInvalidSort
+	}
+}
+
+// priceCurrency returns the currency the products are restricted to, or ""
+// when they are not
+func (q ProductQuery) priceCurrency() string {
+	if q.PriceCurrency == "" && (q.MinPrice != "" || q.MaxPrice != "") {
+		return DefaultCurrency
+	}
+	return q.PriceCurrency
+}
+
+// limit returns q.Limit clamped to the allowed page sizes
+func (q ProductQuery) limit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultPageLimit
+	case q.Limit > MaxPageLimit:
+		return MaxPageLimit
+	default:
+		return q.Limit
+	}
+}
+
+// Validate checks the sort and cursor so callers can reject a bad query up front
+func (q ProductQuery) Validate() error {
+	if _, _, err := q.sortField(); err != nil {
+		return err
+	}
+	for _, a := range []string{q.MinPrice, q.MaxPrice} {
+		if a == "" {
+			continue
+		}
+		if _, err := parseAmount(a); err != nil {
+			return err
+		}
+	}
+	_, err := q.decodeCursor()
+	return err
+}
+
+func (q ProductQuery) decodeCursor() (*cursor, error) {
+	if q.Cursor == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(q.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	// a cursor is only valid for the ordering it was created with
+	if c.Sort != q.Sort {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := primitive.ObjectIDFromHex(c.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.Price != "" {
+		if _, err := NewMoney(c.Price, c.Currency); err != nil {
+			return nil, ErrInvalidCursor
+		}
+		if pc := q.priceCurrency(); pc != "" && c.Currency != pc {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return c, nil
+}
+
+func (q ProductQuery) encodeCursor(p *Product) string {
+	c := cursor{Sort: q.Sort, ID: p.ID}
+
+	switch f, _, _ := q.sortField(); f {
+	case "price":
+		c.Price = p.Price.String()
+		c.Currency = p.Price.Currency
+	case "name":
+		c.Name = p.Name
+	}
+
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// matches reports whether p passes the filters of q
+func (q ProductQuery) matches(p *Product) bool {
+	if p.DeletedAt != nil {
+		return false
+	}
+	if pc := q.priceCurrency(); pc != "" && p.Price.Currency != pc {
+		return false
+	}
+	if r, err := parseAmount(q.MinPrice); err == nil && p.Price.Rat().Cmp(r) < 0 {
+		return false
+	}
+	if r, err := parseAmount(q.MaxPrice); err == nil && p.Price.Rat().Cmp(r) > 0 {
+		return false
+	}
+	if q.SKU != "" && p.SKU != q.SKU {
+		return false
+	}
+	return true
+}
+
+// mongoFilter returns the filter for q, including the keyset condition which
+// skips everything up to and including the cursor
+func (q ProductQuery) mongoFilter() (bson.D, error) {
+	filter := bson.D{notDeleted}
+
+	price := bson.D{}
+	if d, err := primitive.ParseDecimal128(q.MinPrice); err == nil {
+		price = append(price, bson.E{Key: "$gte", Value: d})
+	}
+	if d, err := primitive.ParseDecimal128(q.MaxPrice); err == nil {
+		price = append(price, bson.E{Key: "$lte", Value: d})
+	}
+	if len(price) > 0 {
+		filter = append(filter, bson.E{Key: "price.amount", Value: price})
+	}
+	if pc := q.priceCurrency(); pc != "" {
+		filter = append(filter, bson.E{Key: "price.currency", Value: pc})
+	}
+	if q.SKU != "" {
+		filter = append(filter, bson.E{Key: "sku", Value: q.SKU})
+	}
+
+	c, err := q.decodeCursor()
+	if err != nil || c == nil {
+		return filter, err
+	}
+
+	f, desc, _ := q.sortField()
+	op := "$gt"
+	if desc {
+		op = "$lt"
+	}
+	id, _ := primitive.ObjectIDFromHex(c.ID)
+
+	if f == "" {
+		return append(filter, bson.E{Key: "_id", Value: bson.D{{Key: op, Value: id}}}), nil
+	}
+
+	if f == "price" {
+		// currency, amount and id, in that order
+		amount := MustMoney(c.Price, c.Currency).Decimal128()
+		after := bson.A{
+			bson.D{{Key: "price.currency", Value: bson.D{{Key: op, Value: c.Currency}}}},
+			bson.D{{Key: "price.currency", Value: c.Currency}, {Key: "price.amount", Value: bson.D{{Key: op, Value: amount}}}},
+			bson.D{{Key: "price.currency", Value: c.Currency}, {Key: "price.amount", Value: amount}, {Key: "_id", Value: bson.D{{Key: op, Value: id}}}},
+		}
+		return append(filter, bson.E{Key: "$or", Value: after}), nil
+	}
+
+	var v interface{} = c.Name
+	after := bson.A{
+		bson.D{{Key: f, Value: bson.D{{Key: op, Value: v}}}},
+		bson.D{{Key: f, Value: v}, {Key: "_id", Value: bson.D{{Key: op, Value: id}}}},
+	}
+	return append(filter, bson.E{Key: "$or", Value: after}), nil
+}
+
+// mongoSort returns the sort document for q, _id is always the tie breaker
+// so that the keyset condition in mongoFilter is stable
+func (q ProductQuery) mongoSort() bson.D {
+	f, desc, _ := q.sortField()
+	dir := 1
+	if desc {
+		dir = -1
+	}
+
+	switch f {
+	case "":
+		return bson.D{{Key: "_id", Value: dir}}
+	case "price":
+		return bson.D{{Key: "price.currency", Value: dir}, {Key: "price.amount", Value: dir}, {Key: "_id", Value: dir}}
+	}
+	return bson.D{{Key: f, Value: dir}, {Key: "_id", Value: dir}}
+}
+
+// less orders two products the same way mongoSort does
+func (q ProductQuery) less(a, b *Product) bool {
+	f, desc, _ := q.sortField()
+	if desc {
+		a, b = b, a
+	}
+
+	switch {
+	case f == "price" && a.Price.Currency != b.Price.Currency:
+		// amounts in different currencies are not comparable, the
+		// products are grouped by currency instead
+		return a.Price.Currency < b.Price.Currency
+	case f == "price":
+		if c, _ := a.Price.Cmp(b.Price); c != 0 {
+			return c < 0
+		}
+	case f == "name" && a.Name != b.Name:
+		return a.Name < b.Name
+	}
+	return a.ID < b.ID
+}
+
+// page applies q to an unsorted set of products, it is used by the stores
+// which can not push the query down to a database
+func (q ProductQuery) page(all Products) (*ProductPage, error) {
+	c, err := q.decodeCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	matched := Products{}
+	for _, p := range all {
+		if q.matches(p) {
+			matched = append(matched, p)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return q.less(matched[i], matched[j]) })
+
+	var last *Product
+	if c != nil {
+		last = &Product{ID: c.ID, Name: c.Name}
+		if c.Price != "" {
+			last.Price = MustMoney(c.Price, c.Currency)
+		}
+	}
+
+	res := &ProductPage{Items: Products{}}
+	limit := q.limit()
+	for _, p := range matched {
+		if last != nil && !q.less(last, p) {
+			continue
+		}
+		if len(res.Items) == limit {
+			res.NextCursor = q.encodeCursor(res.Items[limit-1])
+			break
+		}
+		res.Items = append(res.Items, p)
+	}
+
+	return res, nil
+}
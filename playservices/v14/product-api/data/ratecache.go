@@ -0,0 +1,115 @@
+package data
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultRateMaxAge is how long a rate is used before it is fetched again
+// when no update for it has arrived on the subscription stream
+const DefaultRateMaxAge = 5 * time.Minute
+
+// rateEntry is a cached exchange rate and when it was received
+type rateEntry struct {
+	rate    float64
+	updated time.Time
+}
+
+// rateCache holds the latest rate for every currency pair. It is safe for
+// concurrent use, the subscription stream writes to it while request
+// goroutines read from it
+type rateCache struct {
+	mu      sync.RWMutex
+	entries map[string]rateEntry
+	maxAge  time.Duration
+	now     func() time.Time
+
+	// group makes concurrent misses for the same pair share one fetch
+	group singleflight.Group
+}
+
+func newRateCache(maxAge time.Duration) *rateCache {
+	return &rateCache{
+		entries: make(map[string]rateEntry),
+		maxAge:  maxAge,
+		now:     time.Now,
+	}
+}
+
+// rateKey returns the cache key of a currency pair
+func rateKey(base, destination string) string {
+	return base + "/" + destination
+}
+
+// setMaxAge changes the staleness limit of the cache
+func (c *rateCache) setMaxAge(d time.Duration) {
+	c.mu.Lock()
+	c.maxAge = d
+	c.mu.Unlock()
+}
+
+// set stores the rate for key with the current time
+func (c *rateCache) set(key string, rate float64) {
+	c.mu.Lock()
+	c.entries[key] = rateEntry{rate, c.now()}
+	c.mu.Unlock()
+}
+
+// get returns the cached entry for key whatever its age
+func (c *rateCache) get(key string) (rateEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	return e, ok
+}
+
+// fresh returns the rate for key when it is younger than the max age
+func (c *rateCache) fresh(key string) (float64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || c.now().Sub(e.updated) > c.maxAge {
+		return 0, false
+	}
+	return e.rate, true
+}
+
+// fetch returns the fresh rate for key, or calls fn to get a new one and
+// caches it. Concurrent callers missing the same key wait for a single fn
+func (c *rateCache) fetch(key string, fn func() (float64, error)) (float64, error) {
+	if r, ok := c.fresh(key); ok {
+		return r, nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		// another caller may have filled the entry while we were waiting
+		if r, ok := c.fresh(key); ok {
+			return r, nil
+		}
+
+		r, err := fn()
+		if err != nil {
+			return 0.0, err
+		}
+		c.set(key, r)
+		return r, nil
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return v.(float64), nil
+}
+
+// share calls fn once for all the concurrent callers passing the same key,
+// every one of them gets its result
+func (c *rateCache) share(key string, fn func() interface{}) interface{} {
+	v, _, _ := c.group.Do(key, func() (interface{}, error) {
+		return fn(), nil
+	})
+	return v
+}
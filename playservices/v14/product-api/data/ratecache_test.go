@@ -0,0 +1,64 @@
+package data
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateCacheMaxAge(t *testing.T) {
+	now := time.Now()
+	c := newRateCache(time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.set("EUR/USD", 1.1)
+	if r, ok := c.fresh("EUR/USD"); !ok || r != 1.1 {
+		t.Fatalf("expected a fresh rate of 1.1, got %v %v", r, ok)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.fresh("EUR/USD"); ok {
+		t.Fatal("expected the rate to be stale")
+	}
+	if e, ok := c.get("EUR/USD"); !ok || e.rate != 1.1 {
+		t.Fatal("expected a stale rate to still be available")
+	}
+
+	r, err := c.fetch("EUR/USD", func() (float64, error) { return 1.2, nil })
+	if err != nil || r != 1.2 {
+		t.Fatalf("expected the stale rate to be fetched again, got %v %v", r, err)
+	}
+}
+
+func TestRateCacheSharedFetch(t *testing.T) {
+	c := newRateCache(time.Minute)
+
+	var calls int32
+	release := make(chan struct{})
+	fn := func() (float64, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return 1.1, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r, err := c.fetch("EUR/USD", fn); err != nil || r != 1.1 {
+				t.Errorf("unexpected rate %v %v", r, err)
+			}
+		}()
+	}
+
+	// give the goroutines time to pile up behind the first fetch
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("expected a single fetch, got %d", n)
+	}
+}
@@ -0,0 +1,474 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultRateStaleBudget is how old a cached rate can be and still be used
+// while the currency service is unavailable
+const DefaultRateStaleBudget = time.Hour
+
+// rateTimeout bounds a single GetRate call so that an unresponsive currency
+// service does not hold up product requests
+const rateTimeout = 2 * time.Second
+
+// ErrConversionUnavailable is returned together with the products when their
+// prices could not be converted, the prices are left in the stored currency
+var ErrConversionUnavailable = fmt.Errorf("currency conversion unavailable")
+
+// ErrUnknownCurrency is returned instead of converting the prices when the
+// currency service has no rate for a currency that was asked for
+var ErrUnknownCurrency = fmt.Errorf("unknown currency")
+
+// ErrBreakerOpen is returned without calling the currency service while the
+// circuit breaker is open
+var ErrBreakerOpen = fmt.Errorf("currency service circuit breaker is open")
+
+// rateConverter holds the currency service client and the rates received from it.
+// It is shared by every ProductStore implementation so prices are converted the
+// same way regardless of where the products are stored
+type rateConverter struct {
+	currencyClient protos.CurrencyClient
+	l              *zap.Logger
+	rates          *rateCache
+
+	// mu guards the subscription, it is replaced by handleUpdates while
+	// request goroutines send new subscriptions on it
+	mu            sync.Mutex
+	currSubClient protos.Currency_SubscribeRatesClient
+	// pairs are the subscriptions requested so far, they are sent again
+	// every time the stream is re-established
+	pairs map[string]*protos.SubscribeRatesRequest
+
+	state   atomic.Int32
+	backoff backoff
+	stop    context.CancelFunc
+
+	breaker *circuitBreaker
+	// staleBudget is guarded by mu
+	staleBudget time.Duration
+
+	watchers rateWatchers
+}
+
+func newRateConverter(c protos.CurrencyClient, l *zap.Logger) *rateConverter {
+	rc := &rateConverter{
+		currencyClient: c,
+		l:              l,
+		rates:          newRateCache(DefaultRateMaxAge),
+		pairs:          make(map[string]*protos.SubscribeRatesRequest),
+		backoff:        defaultBackoff,
+		stop:           func() {},
+		breaker:        newCircuitBreaker(breakerThreshold, breakerCooldown),
+		staleBudget:    DefaultRateStaleBudget,
+	}
+	rc.state.Store(int32(SubscriptionDisconnected))
+
+	return rc
+}
+
+func getRateConverter(c protos.CurrencyClient, l *zap.Logger) *rateConverter {
+	rc := newRateConverter(c, l)
+
+	if c != nil {
+		rc.start()
+	}
+
+	return rc
+}
+
+// SetRateMaxAge sets how long a rate is used before it is fetched again,
+// rates pushed by the subscription stream keep the cache fresh in between
+func (rc *rateConverter) SetRateMaxAge(d time.Duration) {
+	rc.rates.setMaxAge(d)
+}
+
+// SetRateStaleBudget sets how old a cached rate can be and still be used
+// when the currency service can not be reached
+func (rc *rateConverter) SetRateStaleBudget(d time.Duration) {
+	rc.mu.Lock()
+	rc.staleBudget = d
+	rc.mu.Unlock()
+}
+
+// BreakerStats returns the state and counters of the circuit breaker
+// around the currency service
+func (rc *rateConverter) BreakerStats() BreakerStats {
+	return rc.breaker.snapshot()
+}
+
+// ratePair is a base and destination currency
+type ratePair struct {
+	base        string
+	destination string
+}
+
+// rateRequest returns the RateRequest for p, for GetRate and the
+// subscription stream. Codes which are not in the Currencies enum are
+// rejected instead of silently becoming EUR
+func (p ratePair) rateRequest() (*protos.RateRequest, error) {
+	b, ok := protos.Currencies_value[p.base]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownCurrency, p.base)
+	}
+	d, ok := protos.Currencies_value[p.destination]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownCurrency, p.destination)
+	}
+
+	return &protos.RateRequest{Base: protos.Currencies(b), Destination: protos.Currencies(d)}, nil
+}
+
+// getRate returns the rate from base to destination, from the cache when it
+// is fresh or from the currency service otherwise. When the service can not
+// be reached a cached rate within the stale budget is used instead. A
+// currency converts to itself at 1 without asking the service
+func (rc *rateConverter) getRate(ctx context.Context, base, destination string) (float64, error) {
+	if base == destination {
+		return 1, nil
+	}
+
+	req, err := ratePair{base, destination}.rateRequest()
+	if err != nil {
+		return -1, err
+	}
+	key := rateKey(base, destination)
+
+	r, err := rc.rates.fetch(key, func() (float64, error) {
+		return rc.fetchRate(ctx, req)
+	})
+	if err == nil {
+		return r, nil
+	}
+
+	return rc.staleRate(key, err)
+}
+
+// staleRate returns the cached rate for key when it is within the stale
+// budget, it is used when fetching the rate failed with err
+func (rc *rateConverter) staleRate(key string, err error) (float64, error) {
+	rc.mu.Lock()
+	budget := rc.staleBudget
+	rc.mu.Unlock()
+
+	if e, ok := rc.rates.get(key); ok && rc.rates.now().Sub(e.updated) <= budget {
+		rc.l.Warn("serving a stale rate", zap.String("pair", key), zap.Time("updated", e.updated), zap.Error(err))
+		return e.rate, nil
+	}
+
+	return -1, err
+}
+
+// fetchRate asks the currency service for a rate, through the circuit
+// breaker, and subscribes for its updates so that the cache is kept fresh
+// by the stream. The fetch is shared by every request for the pair, so it
+// keeps the values of ctx, such as the request id, but not its cancellation
+func (rc *rateConverter) fetchRate(ctx context.Context, req *protos.RateRequest) (float64, error) {
+	if rc.currencyClient == nil {
+		return -1, fmt.Errorf("no currency service configured")
+	}
+	gen, ok := rc.breaker.allow()
+	if !ok {
+		return -1, ErrBreakerOpen
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rateTimeout)
+	defer cancel()
+
+	resp, err := rc.currencyClient.GetRate(ctx, req)
+	rc.breaker.done(gen, isUnavailable(err))
+	if err != nil {
+		s := status.Convert(err)
+		switch s.Code() {
+		case codes.InvalidArgument:
+			return -1, fmt.Errorf("base %v and destination currencies %v cannot be the same", req.GetBase(), req.GetDestination())
+		case codes.NotFound:
+			return -1, fmt.Errorf("%w %v: %s", ErrUnknownCurrency, req.GetDestination(), s.Message())
+		}
+		return -1, fmt.Errorf("unable to get rate from currency server for Base: %v, Destination: %v: %s", req.GetBase(), req.GetDestination(), s.Message())
+	}
+
+	rc.subscribe(req)
+
+	return resp.GetRate(), nil
+}
+
+// isUnavailable reports whether err means the currency service is not
+// working, as opposed to rejecting the request, only these trip the breaker
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.OK, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented:
+		return false
+	default:
+		return true
+	}
+}
+
+// rateResult is the rate of a pair or why it could not be found
+type rateResult struct {
+	pair ratePair
+	rate float64
+	err  error
+}
+
+// fetchRates asks the currency service for the rate from base into every
+// destination with a single GetRates call, through the circuit breaker, and
+// subscribes for their updates. GetRates takes ISO 4217 codes so any
+// currency the service has a rate for is converted, only the pairs in the
+// Currencies enum can be subscribed to. There is a result for every
+// destination, those which did not resolve fall back to a rate within the
+// stale budget
+func (rc *rateConverter) fetchRates(ctx context.Context, base string, dests []string) []rateResult {
+	results := make([]rateResult, 0, len(dests))
+	fail := func(d string, err error) {
+		r, err := rc.staleRate(rateKey(base, d), err)
+		results = append(results, rateResult{ratePair{base, d}, r, err})
+	}
+
+	if rc.currencyClient == nil {
+		for _, d := range dests {
+			fail(d, fmt.Errorf("no currency service configured"))
+		}
+		return results
+	}
+	gen, ok := rc.breaker.allow()
+	if !ok {
+		for _, d := range dests {
+			fail(d, ErrBreakerOpen)
+		}
+		return results
+	}
+
+	cctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rateTimeout)
+	defer cancel()
+
+	resp, err := rc.currencyClient.GetRates(cctx, &protos.RatesRequest{Base: base, Destinations: dests})
+	rc.breaker.done(gen, isUnavailable(err))
+	if status.Code(err) == codes.Unimplemented {
+		// a currency service without GetRates, ask for every rate on its own
+		return append(results, rc.fetchEach(ctx, base, dests)...)
+	}
+	if err != nil {
+		s := status.Convert(err)
+		for _, d := range dests {
+			fail(d, fmt.Errorf("unable to get rates from currency server for Base: %s: %s", base, s.Message()))
+		}
+		return results
+	}
+
+	wanted := make(map[string]bool, len(dests))
+	for _, d := range dests {
+		wanted[d] = true
+	}
+	found := make(map[string]bool, len(dests))
+	for _, r := range resp.GetRates() {
+		d := r.GetDestination()
+		if !wanted[d] || found[d] {
+			continue
+		}
+		found[d] = true
+
+		rc.rates.set(rateKey(base, d), r.GetRate())
+		if req, err := (ratePair{base, d}).rateRequest(); err == nil {
+			rc.subscribe(req)
+		}
+		results = append(results, rateResult{ratePair{base, d}, r.GetRate(), nil})
+	}
+	for _, e := range resp.GetErrors() {
+		s := status.FromProto(e)
+		if d := s.Details(); len(d) > 0 {
+			if rr, ok := d[0].(*protos.RateRequestV2); ok && wanted[rr.GetDestination()] && !found[rr.GetDestination()] {
+				found[rr.GetDestination()] = true
+				if s.Code() == codes.NotFound {
+					// the currency does not exist, there is nothing to fall back to
+					results = append(results, rateResult{ratePair{base, rr.GetDestination()}, -1, fmt.Errorf("%w %s: %s", ErrUnknownCurrency, rr.GetDestination(), s.Message())})
+					continue
+				}
+				fail(rr.GetDestination(), fmt.Errorf("unable to get rate from currency server for Base: %s, Destination: %s: %s", base, rr.GetDestination(), s.Message()))
+			}
+		}
+	}
+	for _, d := range dests {
+		if !found[d] {
+			fail(d, fmt.Errorf("no rate from currency server for Base: %s, Destination: %s", base, d))
+		}
+	}
+
+	return results
+}
+
+// fetchShared is fetchRates shared by every concurrent request for the same
+// base and destinations, so a burst of requests missing the same rates makes
+// a single GetRates call
+func (rc *rateConverter) fetchShared(ctx context.Context, base string, dests []string) []rateResult {
+	sort.Strings(dests)
+	key := base + ":" + strings.Join(dests, ",")
+
+	return rc.rates.share(key, func() interface{} {
+		// another caller may have fetched the rates while we were waiting
+		results := make([]rateResult, 0, len(dests))
+		var missing []string
+		for _, d := range dests {
+			if r, ok := rc.rates.fresh(rateKey(base, d)); ok {
+				results = append(results, rateResult{ratePair{base, d}, r, nil})
+				continue
+			}
+			missing = append(missing, d)
+		}
+		if len(missing) == 0 {
+			return results
+		}
+		return append(results, rc.fetchRates(ctx, base, missing)...)
+	}).([]rateResult)
+}
+
+// fetchEach gets the rate from base into every destination with a GetRate
+// call each, in parallel
+func (rc *rateConverter) fetchEach(ctx context.Context, base string, destinations []string) []rateResult {
+	results := make([]rateResult, len(destinations))
+
+	var wg sync.WaitGroup
+	for i, d := range destinations {
+		wg.Add(1)
+		go func(i int, d string) {
+			defer wg.Done()
+			r, err := rc.getRate(ctx, base, d)
+			results[i] = rateResult{ratePair{base, d}, r, err}
+		}(i, d)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// getRates returns the rate of every pair. Fresh rates come from the cache
+// in one pass, the missing ones are fetched with a GetRates call for each
+// base currency, in parallel, so the request waits for a single round trip
+// and the rates from a base all come from the same update. Rates which
+// could not be found are left out and the first error is returned, an
+// unknown currency is reported ahead of any other error
+func (rc *rateConverter) getRates(ctx context.Context, pairs []ratePair) (map[ratePair]float64, error) {
+	rates := make(map[ratePair]float64, len(pairs))
+	missing := map[string][]string{}
+	for _, p := range pairs {
+		if p.base == p.destination {
+			rates[p] = 1
+			continue
+		}
+		if r, ok := rc.rates.fresh(rateKey(p.base, p.destination)); ok {
+			rates[p] = r
+			continue
+		}
+		missing[p.base] = append(missing[p.base], p.destination)
+	}
+
+	results := make(chan []rateResult, len(missing))
+	for base, dests := range missing {
+		go func(base string, dests []string) {
+			results <- rc.fetchShared(ctx, base, dests)
+		}(base, dests)
+	}
+
+	var err error
+	for range missing {
+		for _, res := range <-results {
+			if res.err != nil {
+				if err == nil || errors.Is(res.err, ErrUnknownCurrency) && !errors.Is(err, ErrUnknownCurrency) {
+					err = res.err
+				}
+				continue
+			}
+			rates[res.pair] = res.rate
+		}
+	}
+
+	return rates, err
+}
+
+// convertPrices converts the price of every product, from the currency it
+// is stored in, into currency and fills in Prices with the price in each of
+// currencies. The products are left untouched when no currency is given.
+// Prices which could not be converted stay in their stored currency, or are
+// missing from Prices, and ErrConversionUnavailable is returned. When one of
+// the currencies does not exist nothing is converted and ErrUnknownCurrency
+// is returned
+func (rc *rateConverter) convertPrices(ctx context.Context, ps Products, currency string, currencies ...string) error {
+	wanted := currencies
+	if currency != "" {
+		wanted = append([]string{currency}, currencies...)
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	// products priced in the same currency share their lookups
+	seen := map[ratePair]bool{}
+	var pairs []ratePair
+	for _, v := range ps {
+		for _, c := range wanted {
+			p := ratePair{v.Price.Currency, c}
+			if !seen[p] {
+				seen[p] = true
+				pairs = append(pairs, p)
+			}
+		}
+	}
+
+	rates, err := rc.getRates(ctx, pairs)
+	if errors.Is(err, ErrUnknownCurrency) {
+		return err
+	}
+	if err != nil {
+		rc.l.Error("[ERROR] unable to get rate", zap.Strings("currencies", wanted), zap.Error(err))
+		err = fmt.Errorf("%w: %v", ErrConversionUnavailable, err)
+	}
+
+	for _, v := range ps {
+		base := v.Price
+
+		if len(currencies) > 0 {
+			v.Prices = make(map[string]Money, len(currencies))
+			for _, c := range currencies {
+				if r, ok := rates[ratePair{base.Currency, c}]; ok {
+					v.Prices[c] = base.Convert(r, c)
+				}
+			}
+		}
+
+		if r, ok := rates[ratePair{base.Currency, currency}]; ok && currency != "" {
+			v.Price = base.Convert(r, currency)
+		}
+	}
+
+	return err
+}
+
+func GetgrpcClient(s string, l *zap.Logger) *grpc.ClientConn {
+
+	var opts []grpc.DialOption
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, grpc.WithChainUnaryInterceptor(unaryClientInterceptor(l)))
+	opts = append(opts, grpc.WithChainStreamInterceptor(streamClientInterceptor(l)))
+	conn, err := grpc.NewClient(s, opts...)
+	if err != nil {
+		log.Fatalf("fail to dial: %v", err)
+	}
+	//defer conn.Close()
+
+	return conn
+}
@@ -0,0 +1,246 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// staticCurrency is a currency client answering GetRate and GetRates from
+// a fixed table, destinations which are not in it are NotFound and those in
+// down are Unavailable
+type staticCurrency struct {
+	protos.CurrencyClient
+
+	mu      sync.Mutex
+	rates   map[string]float64
+	down    map[string]bool
+	calls   map[string]int
+	batches int
+	// release holds GetRates back until it is closed, when it is set
+	release chan struct{}
+}
+
+func (s *staticCurrency) GetRate(ctx context.Context, in *protos.RateRequest, opts ...grpc.CallOption) (*protos.RateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := in.GetDestination().String()
+	s.calls[d]++
+	return &protos.RateResponse{Base: in.Base, Destination: in.Destination, Rate: s.rates[d]}, nil
+}
+
+func (s *staticCurrency) GetRates(ctx context.Context, in *protos.RatesRequest, opts ...grpc.CallOption) (*protos.RatesResponse, error) {
+	if s.release != nil {
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batches++
+	resp := &protos.RatesResponse{Base: in.GetBase()}
+	for _, d := range in.GetDestinations() {
+		s.calls[d]++
+		r, ok := s.rates[d]
+		if s.down[d] {
+			st, _ := status.New(codes.Unavailable, "no rate source for currency "+d).WithDetails(&protos.RateRequestV2{Base: in.GetBase(), Destination: d})
+			resp.Errors = append(resp.Errors, st.Proto())
+			continue
+		}
+		if !ok {
+			st, _ := status.New(codes.NotFound, "rate not found for currency "+d).WithDetails(&protos.RateRequestV2{Base: in.GetBase(), Destination: d})
+			resp.Errors = append(resp.Errors, st.Proto())
+			continue
+		}
+		resp.Rates = append(resp.Rates, &protos.RateResponseV2{Base: in.GetBase(), Destination: d, Rate: r})
+	}
+	return resp, nil
+}
+
+// unaryCurrency is a currency service from before GetRates
+type unaryCurrency struct {
+	*staticCurrency
+}
+
+func (u unaryCurrency) GetRates(ctx context.Context, in *protos.RatesRequest, opts ...grpc.CallOption) (*protos.RatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method GetRates")
+}
+
+func TestConvertPricesCurrencies(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"USD": 1.1, "JPY": 160.5, "GBP": 0.85}, calls: map[string]int{}}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	ps := Products{
+		{Name: "Latte", Price: MustMoney("2.45", "EUR")},
+		{Name: "Espresso", Price: MustMoney("1.99", "EUR")},
+	}
+	if err := rc.convertPrices(context.Background(), ps, "GBP", "USD", "JPY", "EUR"); err != nil {
+		t.Fatal(err)
+	}
+
+	p := ps[0]
+	if p.Price.String() != "2.08" || p.Price.Currency != "GBP" {
+		t.Fatalf("expected 2.08 GBP, got %s %s", p.Price, p.Price.Currency)
+	}
+	want := map[string]string{"USD": "2.70", "JPY": "393", "EUR": "2.45"}
+	for c, a := range want {
+		if m, ok := p.Prices[c]; !ok || m.String() != a || m.Currency != c {
+			t.Fatalf("expected %s %s, got %+v", a, c, p.Prices[c])
+		}
+	}
+	if _, ok := p.Prices["GBP"]; ok {
+		t.Fatal("GBP was not asked for in currencies")
+	}
+
+	// every rate is fetched once for the whole page, EUR needs no lookup
+	// and the second call is served from the cache
+	rc.convertPrices(context.Background(), Products{{Name: "Mocha", Price: MustMoney("3.10", "EUR")}}, "", "USD", "JPY")
+	for c, n := range cc.calls {
+		if n != 1 {
+			t.Fatalf("expected one lookup for %s, got %d", c, n)
+		}
+	}
+	if _, ok := cc.calls["EUR"]; ok {
+		t.Fatal("expected no lookup for EUR")
+	}
+}
+
+func TestConvertPricesFromProductCurrency(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"EUR": 1.18, "USD": 1.27}, calls: map[string]int{}}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	ps := Products{
+		{Name: "Tea", Price: MustMoney("1.50", "GBP")},
+		{Name: "Scone", Price: MustMoney("2.00", "GBP")},
+		{Name: "Bagel", Price: MustMoney("3.00", "USD")},
+	}
+	if err := rc.convertPrices(context.Background(), ps, "USD"); err != nil {
+		t.Fatal(err)
+	}
+
+	if ps[0].Price.String() != "1.91" || ps[1].Price.String() != "2.54" {
+		t.Fatalf("expected the GBP prices converted at 1.27, got %s and %s", ps[0].Price, ps[1].Price)
+	}
+	// a product already priced in the destination is not looked up
+	if ps[2].Price.String() != "3.00" || ps[2].Price.Currency != "USD" {
+		t.Fatalf("expected 3.00 USD to be left alone, got %s %s", ps[2].Price, ps[2].Price.Currency)
+	}
+	if len(cc.calls) != 1 || cc.calls["USD"] != 1 {
+		t.Fatalf("expected a single GBP to USD lookup, got %v", cc.calls)
+	}
+}
+
+func TestConvertPricesBatchesRates(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"USD": 1.1, "GBP": 0.85}, down: map[string]bool{"JPY": true}, calls: map[string]int{}}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+	err := rc.convertPrices(context.Background(), ps, "", "USD", "GBP", "JPY")
+	if !errors.Is(err, ErrConversionUnavailable) {
+		t.Fatalf("expected ErrConversionUnavailable for JPY, got %v", err)
+	}
+	if cc.batches != 1 {
+		t.Fatalf("expected one GetRates call, got %d", cc.batches)
+	}
+
+	// the pairs which resolved are converted even though JPY did not
+	p := ps[0]
+	if p.Prices["USD"].String() != "2.70" || p.Prices["GBP"].String() != "2.08" {
+		t.Fatalf("expected 2.70 USD and 2.08 GBP, got %+v", p.Prices)
+	}
+	if _, ok := p.Prices["JPY"]; ok {
+		t.Fatal("expected no JPY price")
+	}
+}
+
+func TestConvertPricesWithoutBatchRates(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"USD": 1.1, "GBP": 0.85}, calls: map[string]int{}}
+	rc := newRateConverter(unaryCurrency{cc}, zap.NewNop())
+
+	ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+	if err := rc.convertPrices(context.Background(), ps, "USD", "GBP"); err != nil {
+		t.Fatal(err)
+	}
+	if ps[0].Price.String() != "2.70" || ps[0].Prices["GBP"].String() != "2.08" {
+		t.Fatalf("expected 2.70 USD and 2.08 GBP, got %s and %+v", ps[0].Price, ps[0].Prices)
+	}
+	if cc.calls["USD"] != 1 || cc.calls["GBP"] != 1 {
+		t.Fatalf("expected a GetRate call for each currency, got %v", cc.calls)
+	}
+}
+
+func TestConvertPricesOutsideCurrenciesEnum(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"VND": 27000, "USD": 1.1}, calls: map[string]int{}}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+	if err := rc.convertPrices(context.Background(), ps, "", "VND", "USD"); err != nil {
+		t.Fatal(err)
+	}
+	if m := ps[0].Prices["VND"]; m.String() != "66150" || m.Currency != "VND" {
+		t.Fatalf("expected 66150 VND, got %+v", m)
+	}
+
+	// only the pair in the Currencies enum can be subscribed to
+	if _, ok := rc.pairs[rateKey("EUR", "VND")]; ok || len(rc.pairs) != 1 {
+		t.Fatalf("expected a subscription for EUR/USD only, got %v", rc.pairs)
+	}
+}
+
+func TestConvertPricesUnknownCurrency(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"USD": 1.1}, calls: map[string]int{}}
+	for name, c := range map[string]protos.CurrencyClient{"GetRates": cc, "GetRate": unaryCurrency{cc}} {
+		rc := newRateConverter(c, zap.NewNop())
+
+		ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+		err := rc.convertPrices(context.Background(), ps, "USD", "XYZ")
+		if !errors.Is(err, ErrUnknownCurrency) || errors.Is(err, ErrConversionUnavailable) {
+			t.Fatalf("%s: expected ErrUnknownCurrency, got %v", name, err)
+		}
+		if ps[0].Price.Currency != "EUR" || ps[0].Prices != nil {
+			t.Fatalf("%s: expected nothing to be converted, got %+v", name, ps[0])
+		}
+		if s := rc.BreakerStats(); s.Failures != 0 {
+			t.Fatalf("%s: expected an unknown currency not to count against the breaker, got %+v", name, s)
+		}
+	}
+}
+
+func TestConvertPricesSharedFetch(t *testing.T) {
+	cc := &staticCurrency{rates: map[string]float64{"USD": 1.1, "GBP": 0.85}, calls: map[string]int{}, release: make(chan struct{})}
+	rc := newRateConverter(cc, zap.NewNop())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ps := Products{{Name: "Latte", Price: MustMoney("2.45", "EUR")}}
+			if err := rc.convertPrices(context.Background(), ps, "", "USD", "GBP"); err != nil {
+				t.Error(err)
+				return
+			}
+			if ps[0].Prices["USD"].String() != "2.70" || ps[0].Prices["GBP"].String() != "2.08" {
+				t.Errorf("expected 2.70 USD and 2.08 GBP, got %+v", ps[0].Prices)
+			}
+		}()
+	}
+
+	// give the goroutines time to pile up behind the first fetch
+	time.Sleep(50 * time.Millisecond)
+	close(cc.release)
+	wg.Wait()
+
+	if cc.batches != 1 {
+		t.Fatalf("expected a single GetRates call, got %d", cc.batches)
+	}
+}
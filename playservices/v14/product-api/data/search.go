@@ -0,0 +1,114 @@
+package data
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// weights used when ranking search results, a match in the name counts
+// for more than one in the description and whole words beat prefixes
+const (
+	nameWeight        = 3.0
+	descriptionWeight = 1.0
+	prefixWeight      = 0.5
+)
+
+// tokenize lower cases s and splits it into words
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// searchIndex is a simple inverted index from the words of a product name and
+// description to the products which contain them. It is not safe for
+// concurrent use, MemoryProductsDB guards it with its own lock
+type searchIndex struct {
+	terms map[string]map[primitive.ObjectID]float64
+	docs  map[primitive.ObjectID][]string
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		terms: make(map[string]map[primitive.ObjectID]float64),
+		docs:  make(map[primitive.ObjectID][]string),
+	}
+}
+
+// add indexes p under id, replacing anything indexed before for that id
+func (si *searchIndex) add(id primitive.ObjectID, p *Product) {
+	si.remove(id)
+
+	weights := map[string]float64{}
+	for _, t := range tokenize(p.Name) {
+		weights[t] += nameWeight
+	}
+	for _, t := range tokenize(p.Description) {
+		weights[t] += descriptionWeight
+	}
+
+	for t, w := range weights {
+		if si.terms[t] == nil {
+			si.terms[t] = make(map[primitive.ObjectID]float64)
+		}
+		si.terms[t][id] = w
+		si.docs[id] = append(si.docs[id], t)
+	}
+}
+
+// remove drops id from the index
+func (si *searchIndex) remove(id primitive.ObjectID) {
+	for _, t := range si.docs[id] {
+		delete(si.terms[t], id)
+		if len(si.terms[t]) == 0 {
+			delete(si.terms, t)
+		}
+	}
+	delete(si.docs, id)
+}
+
+// search returns the ids of the products matching text, best match first.
+// Every word is matched exactly, the last word is also matched as a prefix
+// so that partially typed queries autocomplete
+func (si *searchIndex) search(text string, limit int) []primitive.ObjectID {
+	words := tokenize(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	scores := map[primitive.ObjectID]float64{}
+	for _, w := range words {
+		for id, s := range si.terms[w] {
+			scores[id] += s
+		}
+	}
+
+	last := words[len(words)-1]
+	for t, ids := range si.terms {
+		if t == last || !strings.HasPrefix(t, last) {
+			continue
+		}
+		for id, s := range ids {
+			scores[id] += s * prefixWeight
+		}
+	}
+
+	res := make([]primitive.ObjectID, 0, len(scores))
+	for id := range scores {
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if scores[res[i]] != scores[res[j]] {
+			return scores[res[i]] > scores[res[j]]
+		}
+		return res[i].Hex() < res[j].Hex()
+	})
+
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestMemoryProductsDBSearch(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	db.AddProduct(ctx, []*Product{
+		{Name: "Milk Chocolate", Description: "Not a coffee at all", Price: MustMoney("2.1", "EUR"), SKU: "abc-def-ghi"},
+		{Name: "Mocha", Description: "Coffee with chocolate and milk", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-jkl"},
+	})
+
+	// a match in the name ranks above a match in the description
+	lp, err := db.SearchProducts(ctx, "chocolate", 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp) != 2 || lp[0].Name != "Milk Chocolate" {
+		t.Fatalf("unexpected results %v", lp)
+	}
+
+	// the last word autocompletes
+	lp, _ = db.SearchProducts(ctx, "espr", 0, "")
+	if len(lp) != 1 || lp[0].Name != "Espresso" {
+		t.Fatalf("expected Espresso from prefix search, got %v", lp)
+	}
+
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Macchiato", Price: MustMoney("2.5", "EUR"), SKU: "abc-def-mno"}})
+	lp, _ = db.SearchProducts(ctx, "macchiato", 0, "")
+	if len(lp) != 1 || lp[0].ID != ids[0] {
+		t.Fatalf("expected the new product to be searchable, got %v", lp)
+	}
+}
@@ -0,0 +1,33 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SKUConflict describes a product which could not be written because
+// another product already has its SKU
+type SKUConflict struct {
+	// Item is the position of the product in the slice given to AddProduct,
+	// it is always 0 for an update
+	Item int `json:"item"`
+	// SKU is the duplicated SKU
+	SKU string `json:"sku"`
+	// ExistingID is the id of the product which already has the SKU
+	ExistingID string `json:"existingId"`
+}
+
+// ErrDuplicateSKU is returned when a product would get the SKU of another
+// product. For AddProduct the products which did not conflict have still
+// been inserted
+type ErrDuplicateSKU struct {
+	Conflicts []SKUConflict
+}
+
+func (e *ErrDuplicateSKU) Error() string {
+	skus := make([]string, 0, len(e.Conflicts))
+	for _, c := range e.Conflicts {
+		skus = append(skus, c.SKU)
+	}
+	return fmt.Sprintf("duplicate sku: %s", strings.Join(skus, ", "))
+}
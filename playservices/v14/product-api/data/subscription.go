@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SubscriptionState is the state of the rate subscription stream
+type SubscriptionState int32
+
+const (
+	// SubscriptionDisconnected means there is no stream, it is waiting to reconnect
+	SubscriptionDisconnected SubscriptionState = iota
+	// SubscriptionConnecting means a stream is being opened
+	SubscriptionConnecting
+	// SubscriptionConnected means rate updates are being received
+	SubscriptionConnected
+	// SubscriptionStopped means the subscription has been shut down
+	SubscriptionStopped
+)
+
+func (s SubscriptionState) String() string {
+	switch s {
+	case SubscriptionDisconnected:
+		return "disconnected"
+	case SubscriptionConnecting:
+		return "connecting"
+	case SubscriptionConnected:
+		return "connected"
+	case SubscriptionStopped:
+		return "stopped"
+	default:
+		return fmt.Sprintf("SubscriptionState(%d)", int32(s))
+	}
+}
+
+// backoff is a capped exponential delay with jitter
+type backoff struct {
+	base time.Duration
+	max  time.Duration
+}
+
+var defaultBackoff = backoff{base: 500 * time.Millisecond, max: 30 * time.Second}
+
+// delay returns how long to wait before the given retry, 0 based. The
+// delay is picked at random in the upper half of the exponential step so
+// that many clients restarted together do not reconnect in lock step
+func (b backoff) delay(retry int) time.Duration {
+	d := b.max
+	if retry < 32 {
+		if e := b.base << uint(retry); e > 0 && e < b.max {
+			d = e
+		}
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// SubscriptionState returns the current state of the rate subscription
+func (rc *rateConverter) SubscriptionState() SubscriptionState {
+	return SubscriptionState(rc.state.Load())
+}
+
+// StopRateUpdates closes the rate subscription, cached rates are still
+// used and refreshed with GetRate once they are too old
+func (rc *rateConverter) StopRateUpdates() {
+	rc.stop()
+}
+
+// start runs the subscription loop until StopRateUpdates is called
+func (rc *rateConverter) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	rc.stop = cancel
+
+	go rc.handleUpdates(ctx)
+}
+
+// handleUpdates keeps a subscription stream open, reconnecting with
+// backoff whenever it fails and replaying the requested pairs each time
+func (rc *rateConverter) handleUpdates(ctx context.Context) {
+	defer rc.state.Store(int32(SubscriptionStopped))
+
+	retry := 0
+	for {
+		connected, err := rc.receiveUpdates(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// a stream which was established starts the backoff over
+		if connected {
+			retry = 0
+		}
+		d := rc.backoff.delay(retry)
+		retry++
+		rc.l.Error("rate subscription lost, reconnecting", zap.Error(err), zap.Duration("in", d))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d):
+		}
+	}
+}
+
+// receiveUpdates opens a stream, subscribes to every known pair and stores
+// the rates received until the stream fails. It reports whether the
+// stream was established before failing
+func (rc *rateConverter) receiveUpdates(ctx context.Context) (bool, error) {
+	rc.state.Store(int32(SubscriptionConnecting))
+	defer rc.state.Store(int32(SubscriptionDisconnected))
+
+	subClient, err := rc.currencyClient.SubscribeRates(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// replay under the lock so that a pair added concurrently is either
+	// replayed here or sent by subscribe on the new stream, never missed
+	rc.mu.Lock()
+	n := len(rc.pairs)
+	for key, req := range rc.pairs {
+		if err := subClient.Send(req); err != nil {
+			rc.mu.Unlock()
+			return false, fmt.Errorf("resubscribing to %s: %w", key, err)
+		}
+	}
+	rc.currSubClient = subClient
+	rc.mu.Unlock()
+
+	defer func() {
+		rc.mu.Lock()
+		rc.currSubClient = nil
+		rc.mu.Unlock()
+	}()
+
+	rc.state.Store(int32(SubscriptionConnected))
+	rc.l.Info("subscribed for rate updates", zap.Int("pairs", n))
+
+	for {
+		// Recv returns a StreamingRateResponse which can contain one of two messages
+		// RateResponse or an Error.
+		// We need to handle each case separately
+		sresp, err := subClient.Recv()
+
+		// handle connection errors
+		// this is normally terminal requires a reconnect
+		if err != nil {
+			return true, err
+		}
+
+		// handle a returned error message
+		if ss := sresp.GetError(); ss != nil {
+			rc.l.Error("error subscribing for rates", zap.Any("error", ss))
+			sre := status.FromProto(ss)
+			if sre.Code() == codes.InvalidArgument {
+				errDetails := ""
+				// get the SubscribeRatesRequest serialized in the error response
+				// Details is a collection but we are only returning a single item
+				if d := sre.Details(); len(d) > 0 {
+					rc.l.Error("", zap.Any("details", d))
+					if rr, ok := d[0].(*protos.SubscribeRatesRequest); ok {
+						errDetails = fmt.Sprintf("base: %s destination: %s", rr.GetBase().String(), rr.GetDestination().String())
+					}
+				}
+				rc.l.Error("error receiving message", zap.Any("", errDetails))
+			}
+		}
+
+		// handle a rate response
+		if rresp := sresp.GetRateResponse(); rresp != nil {
+			rc.l.Info("received updated rate from server", zap.Any("destination", rresp.Destination.String()))
+			u := RateUpdate{rresp.GetBase().String(), rresp.GetDestination().String(), rresp.GetRate()}
+			rc.rates.set(rateKey(u.Base, u.Destination), u.Rate)
+			rc.watchers.notify(u)
+		}
+	}
+}
+
+// subscribe remembers a pair and asks the current stream for its updates,
+// when there is no stream the pair is sent once it reconnects
+func (rc *rateConverter) subscribe(req *protos.RateRequest) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	key := rateKey(req.GetBase().String(), req.GetDestination().String())
+	if _, ok := rc.pairs[key]; ok {
+		return
+	}
+	sr := &protos.SubscribeRatesRequest{Base: req.GetBase(), Destination: req.GetDestination()}
+	rc.pairs[key] = sr
+
+	if rc.currSubClient == nil {
+		return
+	}
+	if err := rc.currSubClient.Send(sr); err != nil {
+		// the receive loop sees the broken stream and replays the pair
+		rc.l.Error("unable to subscribe for rate updates", zap.String("pair", key), zap.Error(err))
+	}
+}
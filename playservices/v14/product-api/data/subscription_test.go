@@ -0,0 +1,177 @@
+package data
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeCurrency is an in-process currency service which answers every
+// subscription with the current rate and records what was requested
+type fakeCurrency struct {
+	protos.UnimplementedCurrencyServer
+
+	mu         sync.Mutex
+	rate       float64
+	subscribed []string
+	streams    []protos.Currency_SubscribeRatesServer
+}
+
+func (f *fakeCurrency) GetRate(ctx context.Context, rr *protos.RateRequest) (*protos.RateResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &protos.RateResponse{Base: rr.Base, Destination: rr.Destination, Rate: f.rate}, nil
+}
+
+func (f *fakeCurrency) SubscribeRates(src protos.Currency_SubscribeRatesServer) error {
+	f.mu.Lock()
+	f.streams = append(f.streams, src)
+	f.mu.Unlock()
+
+	for {
+		rr, err := src.Recv()
+		if err != nil {
+			return err
+		}
+
+		f.mu.Lock()
+		f.subscribed = append(f.subscribed, rr.GetDestination().String())
+		f.mu.Unlock()
+	}
+}
+
+// push sends a new rate for destination on every open stream
+func (f *fakeCurrency) push(destination protos.Currencies, rate float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range f.streams {
+		s.Send(&protos.StreamingRateResponse{
+			Message: &protos.StreamingRateResponse_RateResponse{
+				RateResponse: &protos.RateResponse{Base: protos.Currencies_EUR, Destination: destination, Rate: rate},
+			},
+		})
+	}
+}
+
+func (f *fakeCurrency) subscriptions() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.subscribed...)
+}
+
+// bufServer is a currency server on an in-memory listener which can be
+// stopped and started again behind the same client connection
+type bufServer struct {
+	mu  sync.Mutex
+	lis *bufconn.Listener
+	srv *grpc.Server
+}
+
+func (b *bufServer) start(f *fakeCurrency) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lis = bufconn.Listen(1 << 20)
+	b.srv = grpc.NewServer()
+	protos.RegisterCurrencyServer(b.srv, f)
+	go b.srv.Serve(b.lis)
+}
+
+func (b *bufServer) stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.srv.Stop()
+}
+
+func (b *bufServer) dial(ctx context.Context, _ string) (net.Conn, error) {
+	b.mu.Lock()
+	lis := b.lis
+	b.mu.Unlock()
+	return lis.DialContext(ctx)
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRateSubscriptionReconnects(t *testing.T) {
+	bs := &bufServer{}
+	f1 := &fakeCurrency{rate: 1.1}
+	bs.start(f1)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(bs.dial),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	rc := newRateConverter(protos.NewCurrencyClient(conn), zap.NewNop())
+	rc.backoff = backoff{base: 10 * time.Millisecond, max: 50 * time.Millisecond}
+	rc.start()
+	defer rc.StopRateUpdates()
+
+	waitFor(t, "the first connection", func() bool { return rc.SubscriptionState() == SubscriptionConnected })
+
+	if r, err := rc.getRate(context.Background(), "EUR", "USD"); err != nil || r != 1.1 {
+		t.Fatalf("expected a rate of 1.1, got %v %v", r, err)
+	}
+	waitFor(t, "the USD subscription", func() bool { return len(f1.subscriptions()) == 1 })
+
+	bs.stop()
+	waitFor(t, "the disconnect", func() bool { return rc.SubscriptionState() != SubscriptionConnected })
+
+	f2 := &fakeCurrency{rate: 1.2}
+	bs.start(f2)
+	defer bs.stop()
+
+	// the pair requested before the outage is replayed without a new request
+	waitFor(t, "the replayed subscription", func() bool {
+		s := f2.subscriptions()
+		return len(s) == 1 && s[0] == "USD"
+	})
+	waitFor(t, "the reconnect", func() bool { return rc.SubscriptionState() == SubscriptionConnected })
+
+	f2.push(protos.Currencies_USD, 1.3)
+	waitFor(t, "the pushed rate", func() bool {
+		e, _ := rc.rates.get(rateKey("EUR", "USD"))
+		return e.rate == 1.3
+	})
+
+	rc.StopRateUpdates()
+	waitFor(t, "the stop", func() bool { return rc.SubscriptionState() == SubscriptionStopped })
+}
+
+func TestBackoffDelay(t *testing.T) {
+	b := backoff{base: 100 * time.Millisecond, max: time.Second}
+
+	for retry, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		for i := 0; i < 20; i++ {
+			if d := b.delay(retry); d < max/2 || d > max {
+				t.Fatalf("retry %d: delay %v outside [%v, %v]", retry, d, max/2, max)
+			}
+		}
+	}
+
+	if d := b.delay(100); d > time.Second {
+		t.Fatalf("expected the delay to be capped, got %v", d)
+	}
+}
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeTrash removes the products which have been in the trash for longer
+// than retention, it checks every interval until ctx is cancelled
+func PurgeTrash(ctx context.Context, db ProductStore, retention, interval time.Duration, l *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := db.PurgeDeletedProducts(ctx, time.Now().Add(-retention))
+			if err != nil {
+				l.Error("error purging the trash", zap.Error(err))
+				continue
+			}
+			if n > 0 {
+				l.Info("purged products from the trash", zap.Int64("count", n))
+			}
+		}
+	}
+}
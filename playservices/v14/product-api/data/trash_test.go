@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+func TestMemoryProductsDBTrash(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+
+	if err := db.DeleteProduct(ctx, id, "admin"); err != nil {
+		t.Fatal(err)
+	}
+
+	// deleted products are hidden from the normal reads
+	if _, err := db.GetProductByID(ctx, id, ""); err != ErrProductNotFound {
+		t.Fatalf("expected ErrProductNotFound, got %v", err)
+	}
+	lp, _ := db.GetProducts(ctx, ProductQuery{}, "")
+	if len(lp.Items) != len(ProductList) {
+		t.Fatalf("expected the deleted product to be excluded, got %d products", len(lp.Items))
+	}
+	if sp, _ := db.SearchProducts(ctx, "mocha", 0, ""); len(sp) != 0 {
+		t.Fatalf("expected no search results for a deleted product, got %v", sp)
+	}
+	if err := db.DeleteProduct(ctx, id, "admin"); err != ErrProductNotFound {
+		t.Fatalf("expected a second delete to return ErrProductNotFound, got %v", err)
+	}
+
+	trash, _ := db.GetDeletedProducts(ctx)
+	if len(trash) != 1 || trash[0].ID != ids[0] || trash[0].DeletedBy != "admin" || trash[0].DeletedAt == nil {
+		t.Fatalf("unexpected trash %v", trash)
+	}
+
+	if err := db.RestoreProduct(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GetProductByID(ctx, id, ""); err != nil {
+		t.Fatalf("expected the restored product to be readable, got %v", err)
+	}
+	if err := db.RestoreProduct(ctx, id); err != ErrProductNotFound {
+		t.Fatalf("expected restoring a live product to return ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestMemoryProductsDBTrashSKU(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+	db.DeleteProduct(ctx, id, "admin")
+
+	// the SKU of a deleted product can be used again
+	nids, err := db.AddProduct(ctx, []*Product{{Name: "White Mocha", Price: MustMoney("3.4", "EUR"), SKU: "abc-def-ghi"}})
+	if err != nil {
+		t.Fatalf("expected the SKU in the trash to be free, got %v", err)
+	}
+
+	// which keeps the deleted product from being restored
+	err = db.RestoreProduct(ctx, id)
+	if dup, ok := err.(*ErrDuplicateSKU); !ok || dup.Conflicts[0].ExistingID != nids[0] {
+		t.Fatalf("expected ErrDuplicateSKU naming the new product, got %v", err)
+	}
+	if trash, _ := db.GetDeletedProducts(ctx); len(trash) != 1 {
+		t.Fatalf("expected the product to stay in the trash, got %v", trash)
+	}
+
+	// purging it leaves the SKU with the new product
+	db.PurgeDeletedProducts(ctx, time.Now().Add(time.Second))
+	_, err = db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	if _, ok := err.(*ErrDuplicateSKU); !ok {
+		t.Fatalf("expected ErrDuplicateSKU after the purge, got %v", err)
+	}
+}
+
+func TestMemoryProductsDBPurge(t *testing.T) {
+	ctx := context.Background()
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+	db.DeleteProduct(ctx, id, "admin")
+
+	// nothing has been in the trash for an hour yet
+	n, _ := db.PurgeDeletedProducts(ctx, time.Now().Add(-time.Hour))
+	if n != 0 {
+		t.Fatalf("expected nothing to be purged, got %d", n)
+	}
+
+	n, _ = db.PurgeDeletedProducts(ctx, time.Now().Add(time.Second))
+	if n != 1 {
+		t.Fatalf("expected one product to be purged, got %d", n)
+	}
+	if err := db.RestoreProduct(ctx, id); err != ErrProductNotFound {
+		t.Fatalf("expected a purged product to be gone, got %v", err)
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	db := GetMemoryProductsDB(nil, zap.NewNop())
+	ids, _ := db.AddProduct(ctx, []*Product{{Name: "Mocha", Price: MustMoney("3.1", "EUR"), SKU: "abc-def-ghi"}})
+	id, _ := primitive.ObjectIDFromHex(ids[0])
+	db.DeleteProduct(ctx, id, "admin")
+
+	done := make(chan struct{})
+	go func() {
+		PurgeTrash(ctx, db, 0, time.Millisecond, zap.NewNop())
+		close(done)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		trash, _ := db.GetDeletedProducts(ctx)
+		if len(trash) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the purge job to empty the trash")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	<-done
+}
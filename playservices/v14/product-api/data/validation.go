@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -43,6 +44,7 @@ type Validation struct {
 func NewValidation() *Validation {
 	validate := validator.New()
 	validate.RegisterValidation("sku", validateSKU)
+	validate.RegisterValidation("money", validateMoney)
 
 	return &Validation{validate}
 }
@@ -71,3 +73,15 @@ func validateSKU(fl validator.FieldLevel) bool {
 
 	return len(sku) == 1
 }
+
+// validateMoney checks a price is positive and in a currency the currency
+// service can convert from
+func validateMoney(fl validator.FieldLevel) bool {
+	m, ok := fl.Field().Interface().(Money)
+	if !ok {
+		return false
+	}
+
+	_, known := protos.Currencies_value[m.Currency]
+	return m.Minor > 0 && known
+}
@@ -0,0 +1,22 @@
+package data
+
+import "testing"
+
+func TestValidateProduct(t *testing.T) {
+	v := NewValidation()
+
+	p := &Product{Name: "Latte", Price: MustMoney("2.45", "EUR"), SKU: "abc-def-ghi"}
+	if errs := v.Validate(p); len(errs) != 0 {
+		t.Fatal(errs.Errors())
+	}
+
+	p.Price = MustMoney("2.45", "XYZ")
+	if errs := v.Validate(p); len(errs) != 1 {
+		t.Fatalf("expected an unknown currency to fail validation, got %v", errs.Errors())
+	}
+
+	p.Price = Money{}
+	if errs := v.Validate(p); len(errs) != 1 {
+		t.Fatalf("expected a price validation error, got %v", errs.Errors())
+	}
+}
@@ -0,0 +1,79 @@
+package data
+
+import "sync"
+
+// RateUpdate is a rate pushed by the currency service
+type RateUpdate struct {
+	Base        string  `json:"base"`
+	Destination string  `json:"destination"`
+	Rate        float64 `json:"rate"`
+}
+
+// rateWatchers fans the rate updates received on the subscription stream
+// out to the price streams. It never blocks the subscription, a watcher
+// which has not taken the previous update only gets the latest one
+type rateWatchers struct {
+	mu       sync.Mutex
+	next     int
+	watchers map[int]rateWatcher
+}
+
+type rateWatcher struct {
+	destination string
+	ch          chan RateUpdate
+}
+
+// WatchRates returns a channel receiving the rate updates into destination
+// and a function which stops the watch. An update a slow reader has not
+// received yet is replaced by the newer one, every update is a signal that
+// the prices in destination have to be computed again so none is lost
+func (rc *rateConverter) WatchRates(destination string) (<-chan RateUpdate, func()) {
+	w := &rc.watchers
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.watchers == nil {
+		w.watchers = make(map[int]rateWatcher)
+	}
+	id := w.next
+	w.next++
+	ch := make(chan RateUpdate, 1)
+	w.watchers[id] = rateWatcher{destination, ch}
+
+	return ch, func() {
+		w.mu.Lock()
+		delete(w.watchers, id)
+		w.mu.Unlock()
+	}
+}
+
+// notify hands u to every watcher of its destination without waiting
+// for any of them
+func (w *rateWatchers) notify(u RateUpdate) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, rw := range w.watchers {
+		if rw.destination != u.Destination {
+			continue
+		}
+
+		ch := rw.ch
+		select {
+		case ch <- u:
+			continue
+		default:
+		}
+
+		// drop the stale update the watcher has not read yet
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- u:
+		default:
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestWatchRatesKeepsLatestUpdate(t *testing.T) {
+	rc := newRateConverter(nil, zap.NewNop())
+
+	usd, stopUSD := rc.WatchRates("USD")
+	defer stopUSD()
+	jpy, stopJPY := rc.WatchRates("JPY")
+
+	rc.watchers.notify(RateUpdate{"EUR", "USD", 1.1})
+	rc.watchers.notify(RateUpdate{"EUR", "USD", 1.2})
+
+	if u := <-usd; u.Rate != 1.2 {
+		t.Fatalf("expected the latest rate 1.2, got %v", u.Rate)
+	}
+	select {
+	case u := <-usd:
+		t.Fatalf("expected a single pending update, got %v", u)
+	case u := <-jpy:
+		t.Fatalf("expected no update for another destination, got %v", u)
+	default:
+	}
+
+	stopJPY()
+	rc.watchers.notify(RateUpdate{"EUR", "JPY", 160})
+	select {
+	case u := <-jpy:
+		t.Fatalf("expected no update after the watch stopped, got %v", u)
+	default:
+	}
+}
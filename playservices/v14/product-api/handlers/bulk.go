@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.uber.org/zap"
+)
+
+// importBatchSize is the number of valid rows inserted with a single write
+const importBatchSize = 500
+
+// ImportReport is the result of a bulk import
+// swagger:model
+type ImportReport struct {
+	// number of products inserted
+	Imported int `json:"imported"`
+	// number of rows which were rejected
+	Failed int `json:"failed"`
+	// the reason each rejected row failed
+	Errors []data.RowError `json:"errors"`
+}
+
+// bulkFormat returns the format asked for by the format query parameter,
+// falling back to the Content-Type of the request
+func bulkFormat(r *http.Request) (data.BulkFormat, bool) {
+	f := r.URL.Query().Get("format")
+	if f == "" && r.Method != http.MethodGet {
+		mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		switch mt {
+		case "text/csv":
+			f = string(data.FormatCSV)
+		case "application/x-ndjson", "application/ndjson":
+			f = string(data.FormatNDJSON)
+		}
+	}
+
+	switch data.BulkFormat(f) {
+	case "", data.FormatNDJSON:
+		return data.FormatNDJSON, true
+	case data.FormatCSV:
+		return data.FormatCSV, true
+	default:
+		return "", false
+	}
+}
+
+// swagger:route GET /products/export products exportProducts
+// Stream every product which is not in the trash as CSV or NDJSON
+// responses:
+//	200: productsExportResponse
+//	400: errorResponse
+
+// Export handles GET requests and streams all products in the requested format
+func (p *ProductsHandler) Export(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle GET Products export")
+
+	f, ok := bulkFormat(r)
+	if !ok {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: "format must be csv or ndjson"}, w)
+		return
+	}
+
+	// an export of a large collection takes longer than the server write
+	// timeout, instead every write gets its own deadline
+	rc := http.NewResponseController(w)
+
+	w.Header().Add("Content-Type", f.ContentType())
+	w.Header().Add("Content-Disposition", `attachment; filename="products.`+string(f)+`"`)
+
+	enc := data.NewProductEncoder(f, &deadlineWriter{w, rc})
+	err := p.db.ExportProducts(r.Context(), func(prod *data.Product) error {
+		return enc.Encode(prod)
+	})
+	if err == nil {
+		err = enc.Flush()
+	}
+	if err != nil {
+		// the status has already been sent, all we can do is cut the stream short
+		p.l.Error("unable to export products", zap.Error(err))
+		return
+	}
+	rc.Flush()
+}
+
+// deadlineWriter moves the write deadline forward before every write, so a
+// client which stops reading is disconnected after streamWriteTimeout
+type deadlineWriter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (d *deadlineWriter) Write(b []byte) (int, error) {
+	d.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	return d.w.Write(b)
+}
+
+// swagger:route POST /products/import products importProducts
+// Import products from a CSV or NDJSON body, every row is validated on its
+// own and rows which fail are reported instead of aborting the import
+// responses:
+//	200: productsImportResponse
+//	400: errorResponse
+//	500: errorResponse
+
+// Import handles POST requests and inserts the products in the body in batches
+func (p *ProductsHandler) Import(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle POST Products import")
+
+	w.Header().Add("Content-Type", "application/json")
+
+	f, ok := bulkFormat(r)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: "format must be csv or ndjson"}, w)
+		return
+	}
+
+	// a large upload, and the batches it is written in, take longer than
+	// the server read and write timeouts
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Time{})
+	rc.SetWriteDeadline(time.Time{})
+
+	report := &ImportReport{Errors: []data.RowError{}}
+	batch := []*data.Product{}
+	// rows holds the row number of each product in batch
+	rows := []int{}
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		ids, err := p.db.AddProduct(r.Context(), batch)
+		report.Imported += len(ids)
+
+		// a duplicate SKU only fails its own row
+		var dup *data.ErrDuplicateSKU
+		if errors.As(err, &dup) {
+			for _, c := range dup.Conflicts {
+				report.Errors = append(report.Errors, data.RowError{
+					Row:      rows[c.Item],
+					Messages: []string{fmt.Sprintf("sku %s is already used by product %s", c.SKU, c.ExistingID)},
+				})
+			}
+			err = nil
+		}
+
+		batch = batch[:0]
+		rows = rows[:0]
+		return err
+	}
+
+	dec := data.NewProductDecoder(f, r.Body)
+	row := 0
+	for {
+		prod, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+
+		var re *data.RowError
+		switch {
+		case errors.As(err, &re):
+			row = re.Row
+			report.Errors = append(report.Errors, *re)
+			continue
+		case err != nil:
+			w.WriteHeader(http.StatusBadRequest)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+		row++
+
+		if errs := p.v.Validate(prod); len(errs) != 0 {
+			report.Errors = append(report.Errors, data.RowError{Row: row, Messages: errs.Errors()})
+			continue
+		}
+
+		batch = append(batch, prod)
+		rows = append(rows, row)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				p.importFailed(w, report, err)
+				return
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		p.importFailed(w, report, err)
+		return
+	}
+
+	sort.Slice(report.Errors, func(i, j int) bool { return report.Errors[i].Row < report.Errors[j].Row })
+	report.Failed = len(report.Errors)
+	data.ToJSON(report, w)
+}
+
+// importFailed reports a write error part way through an import, the rows
+// counted as imported so far have been stored
+func (p *ProductsHandler) importFailed(w http.ResponseWriter, report *ImportReport, err error) {
+	p.l.Error("error importing products", zap.Error(err), zap.Int("imported", report.Imported))
+
+	w.WriteHeader(http.StatusInternalServerError)
+	data.ToJSON(&GenericError{Message: err.Error()}, w)
+}
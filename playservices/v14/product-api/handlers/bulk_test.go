@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.uber.org/zap"
+)
+
+// slowStore is the in-memory store with a delay on every write
+type slowStore struct {
+	*data.MemoryProductsDB
+	delay time.Duration
+}
+
+func (s *slowStore) AddProduct(ctx context.Context, p []*data.Product) ([]string, error) {
+	time.Sleep(s.delay)
+	return s.MemoryProductsDB.AddProduct(ctx, p)
+}
+
+// skuFor returns a valid SKU which is different for every i
+func skuFor(i int) string {
+	b := []byte("aaaa")
+	for j := len(b) - 1; j >= 0; j-- {
+		b[j] = byte('a' + i%26)
+		i /= 26
+	}
+	return "imp-row-" + string(b)
+}
+
+func TestImportOutlivesWriteTimeout(t *testing.T) {
+	db := &slowStore{data.GetMemoryProductsDB(nil, zap.NewNop()), 150 * time.Millisecond}
+	ph := NewProducts(zap.NewNop(), data.NewValidation(), nil, db)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(ph.Import))
+	srv.Config.WriteTimeout = 100 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	// two batches, each one slower than the write timeout
+	var body strings.Builder
+	rows := importBatchSize + 10
+	for i := 0; i < rows; i++ {
+		fmt.Fprintf(&body, `{"name":"Row %d","price":{"amount":"1.00","currency":"EUR"},"sku":%q}`+"\n", i, skuFor(i))
+	}
+	body.WriteString(`{"name":"Duplicate","price":{"amount":"1.00","currency":"EUR"},"sku":"` + skuFor(0) + `"}` + "\n")
+
+	resp, err := http.Post(srv.URL+"?format=ndjson", "application/x-ndjson", strings.NewReader(body.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var report ImportReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		t.Fatalf("expected the import report, got %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || report.Imported != rows || report.Failed != 1 {
+		t.Fatalf("expected %d imported and 1 failed, got %d %+v", rows, resp.StatusCode, report)
+	}
+	if report.Errors[0].Row != rows+1 {
+		t.Fatalf("expected the duplicate on row %d, got %+v", rows+1, report.Errors[0])
+	}
+}
+
+func TestImportReport(t *testing.T) {
+	ph := NewProducts(zap.NewNop(), data.NewValidation(), nil, data.GetMemoryProductsDB(nil, zap.NewNop()))
+
+	body := strings.Join([]string{
+		"id,name,description,price,currency,sku,version",
+		",Mocha,,3.10,EUR,abc-def-ghi,",
+		",Latte,,not a price,EUR,abc-def-jkl,",
+		",Espresso,,1.90,EUR,ABC123,",
+		",Flat White,,2.80,EUR,abc-def-mno,",
+		",Mocha again,,3.10,EUR,abc-def-ghi,",
+	}, "\n") + "\n"
+
+	r := httptest.NewRequest(http.MethodPost, "/products/import?format=csv", strings.NewReader(body))
+	rw := httptest.NewRecorder()
+	ph.Import(rw, r)
+
+	var report ImportReport
+	if err := json.NewDecoder(rw.Body).Decode(&report); err != nil {
+		t.Fatalf("expected the import report, got %v", err)
+	}
+	if rw.Code != http.StatusOK || report.Imported != 2 || report.Failed != 3 {
+		t.Fatalf("expected 2 imported and 3 failed, got %d %+v", rw.Code, report)
+	}
+
+	// a bad price, a failed validation and a duplicate SKU, in row order
+	for i, row := range []int{2, 3, 5} {
+		if e := report.Errors[i]; e.Row != row || len(e.Messages) == 0 {
+			t.Fatalf("expected error %d on row %d, got %+v", i, row, e)
+		}
+	}
+	if !strings.Contains(report.Errors[2].Messages[0], "abc-def-ghi") {
+		t.Fatalf("expected the duplicate SKU to be named, got %v", report.Errors[2].Messages)
+	}
+}
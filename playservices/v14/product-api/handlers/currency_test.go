@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// rateService is an in-process currency service with EUR rates from a
+// table, currencies which are not in it are NotFound. push changes a rate
+// and sends it on the subscription streams
+type rateService struct {
+	protos.UnimplementedCurrencyServer
+
+	mu      sync.Mutex
+	rates   map[string]float64
+	streams []protos.Currency_SubscribeRatesServer
+}
+
+func (s *rateService) GetRate(ctx context.Context, rr *protos.RateRequest) (*protos.RateResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rates[rr.GetDestination().String()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "rate not found for currency %s", rr.GetDestination())
+	}
+	return &protos.RateResponse{Base: rr.Base, Destination: rr.Destination, Rate: r}, nil
+}
+
+func (s *rateService) GetRates(ctx context.Context, rr *protos.RatesRequest) (*protos.RatesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &protos.RatesResponse{Base: rr.GetBase()}
+	for _, d := range rr.GetDestinations() {
+		r, ok := s.rates[d]
+		if !ok {
+			st, _ := status.Newf(codes.NotFound, "rate not found for currency %s", d).WithDetails(&protos.RateRequestV2{Base: rr.GetBase(), Destination: d})
+			resp.Errors = append(resp.Errors, st.Proto())
+			continue
+		}
+		resp.Rates = append(resp.Rates, &protos.RateResponseV2{Base: rr.GetBase(), Destination: d, Rate: r})
+	}
+	return resp, nil
+}
+
+func (s *rateService) SubscribeRates(src protos.Currency_SubscribeRatesServer) error {
+	s.mu.Lock()
+	s.streams = append(s.streams, src)
+	s.mu.Unlock()
+
+	for {
+		if _, err := src.Recv(); err != nil {
+			return err
+		}
+	}
+}
+
+// push sets the rate into destination and sends it on every open stream
+func (s *rateService) push(destination protos.Currencies, rate float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rates[destination.String()] = rate
+	for _, st := range s.streams {
+		st.Send(&protos.StreamingRateResponse{
+			Message: &protos.StreamingRateResponse_RateResponse{
+				RateResponse: &protos.RateResponse{Base: protos.Currencies_EUR, Destination: destination, Rate: rate},
+			},
+		})
+	}
+}
+
+// newRatesHandler returns a handler on an in-memory store, converting prices
+// with rs, which holds one product priced at 3.10 EUR
+func newRatesHandler(t *testing.T, rs *rateService) (*ProductsHandler, string) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	protos.RegisterCurrencyServer(srv, rs)
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := data.GetMemoryProductsDB(protos.NewCurrencyClient(conn), zap.NewNop())
+	t.Cleanup(func() {
+		db.StopRateUpdates()
+		conn.Close()
+		srv.Stop()
+	})
+
+	ids, err := db.AddProduct(context.Background(), []*data.Product{{Name: "Mocha", Price: data.MustMoney("3.10", "EUR"), SKU: "abc-def-ghi"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewProducts(zap.NewNop(), data.NewValidation(), nil, db), ids[0]
+}
+
+// waitFor polls cond until it holds or fails the test after a few seconds
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -8,21 +8,22 @@ import (
 )
 
 // swagger:route DELETE /products/{id} products deleteProduct
-// Update a products details
+// Move a product to the trash
 //
 // responses:
 //	201: noContentResponse
 //  404: errorResponse
 //  501: errorResponse
 
-// Delete handles DELETE requests and removes items from the database
+// Delete handles DELETE requests and moves items to the trash,
+// they can be restored until the purge job removes them
 func (p *ProductsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	id := p.getProductID(r)
 
 	p.l.Info("deleting record", zap.Any("id:", id))
 
-	err := p.db.DeleteProduct(r.Context(), id)
+	err := p.db.DeleteProduct(r.Context(), id, getUser(r))
 	if err == data.ErrProductNotFound {
 		p.l.Error("deleting record id does not exist", zap.Error(err))
 
@@ -37,14 +37,67 @@ type errorValidationWrapper struct {
 	Body ValidationError
 }
 
-// A list of products
+// The SKU of a product is already used by another product
+// swagger:response errorConflict
+type errorConflictWrapper struct {
+	// The conflicting SKUs and the products which have them
+	// in: body
+	Body ConflictError
+}
+
+// A page of products
 // swagger:response productsResponse
 type productsResponseWrapper struct {
-	// All current products
+	// Set to unavailable when the prices could not be converted and are in their stored currency
+	// in: header
+	XCurrencyConversion string `json:"X-Currency-Conversion"`
+	// The products on this page and the cursor for the next one
+	// in: body
+	Body data.ProductPage
+}
+
+// The products matching a search, best match first
+// swagger:response productsSearchResponse
+type productsSearchResponseWrapper struct {
+	// Set to unavailable when the prices could not be converted and are in their stored currency
+	// in: header
+	XCurrencyConversion string `json:"X-Currency-Conversion"`
+	// Matching products
 	// in: body
 	Body []data.Product
 }
 
+// The products in the trash, most recently deleted first
+// swagger:response productsTrashResponse
+type productsTrashResponseWrapper struct {
+	// Deleted products
+	// in: body
+	Body []data.Product
+}
+
+// Every product which is not in the trash, one per line for NDJSON or one
+// per row after a header for CSV
+// swagger:response productsExportResponse
+type productsExportResponseWrapper struct {
+	// in: body
+	Body string
+}
+
+// The outcome of a bulk import
+// swagger:response productsImportResponse
+type productsImportResponseWrapper struct {
+	// in: body
+	Body ImportReport
+}
+
+// A stream of price events, as Server-Sent Events with the prices event
+// name or as WebSocket JSON messages
+// swagger:response priceStreamResponse
+type priceStreamResponseWrapper struct {
+	// in: body
+	Body PriceEvent
+}
+
 // Data structure representing a single product
 // swagger:response productResponse
 type productResponseWrapper struct {
@@ -53,6 +106,34 @@ type productResponseWrapper struct {
 	Body data.Product
 }
 
+// Data structure representing a single product with its version
+// swagger:response productVersionResponse
+type productVersionResponseWrapper struct {
+	// Set to unavailable when the prices could not be converted and are in their stored currency
+	// in: header
+	XCurrencyConversion string `json:"X-Currency-Conversion"`
+	// The product version, followed by a hash of the converted prices when a
+	// currency was asked for, send it back in If-Match when updating the product
+	// in: header
+	ETag string
+	// The requested product
+	// in: body
+	Body data.Product
+}
+
+// The patch was applied
+// swagger:response productPatchedResponse
+type productPatchedResponseWrapper struct {
+	// The new product version
+	// in: header
+	ETag string
+}
+
+// The product has not changed since the version in If-None-Match
+// swagger:response notModifiedResponse
+type notModifiedResponseWrapper struct {
+}
+
 // No content is returned by this API endpoint
 // swagger:response noContentResponse
 type noContentResponseWrapper struct {
@@ -67,16 +148,139 @@ type productParamsWrapper struct {
 	Body data.Product
 }
 
-// swagger:parameters listProducts listSingleProduct
+// swagger:parameters listProducts listSingleProduct searchProducts
 type productQueryParam struct {
 	// Currency used when returning the price of the product,
 	// when not specified currency is returned in GBP.
+	// A currency the currency service has no rate for is rejected with a 400
 	// in: query
 	// required: false
 	Currency string
 }
 
-// swagger:parameters listSingleProduct deleteProduct
+// swagger:parameters listProducts listSingleProduct
+type productCurrenciesParamWrapper struct {
+	// Comma separated ISO 4217 codes, such as USD,GBP,JPY, the price in each
+	// currency is returned in the prices object of every product, a currency
+	// the currency service has no rate for is rejected with a 400
+	// in: query
+	// required: false
+	Currencies string `json:"currencies"`
+}
+
+// swagger:parameters updateProduct
+type productIfMatchParamWrapper struct {
+	// ETag returned by GET /products/{id}, the update is rejected
+	// with 412 when the product has changed since
+	// in: header
+	// required: true
+	IfMatch string `json:"If-Match"`
+}
+
+// swagger:parameters deleteProduct
+type productDeletedByParamWrapper struct {
+	// Who is deleting the product, recorded as deletedBy
+	// in: header
+	// required: false
+	User string `json:"X-User"`
+}
+
+// swagger:parameters listProducts streamPrices streamPricesWebSocket
+type productListParamsWrapper struct {
+	// Maximum number of products to return, defaults to 50 and is capped at 200
+	// in: query
+	// required: false
+	Limit int `json:"limit"`
+
+	// Opaque cursor taken from the nextCursor of the previous page
+	// in: query
+	// required: false
+	Cursor string `json:"cursor"`
+
+	// Sort order, one of price, -price, name or -name, sorting by price groups the products by the currency they are priced in
+	// in: query
+	// required: false
+	Sort string `json:"sort"`
+
+	// Only return products priced at greater than or equal to this decimal amount of priceCurrency
+	// in: query
+	// required: false
+	MinPrice string `json:"minPrice"`
+
+	// Only return products priced at less than or equal to this decimal amount of priceCurrency
+	// in: query
+	// required: false
+	MaxPrice string `json:"maxPrice"`
+
+	// Only return the product with this SKU
+	// in: query
+	// required: false
+	SKU string `json:"sku"`
+
+	// Only return the products priced in this ISO 4217 currency, it is the
+	// currency of minPrice and maxPrice and defaults to EUR when they are set
+	// in: query
+	// required: false
+	PriceCurrency string `json:"priceCurrency"`
+}
+
+// swagger:parameters searchProducts
+type productSearchParamsWrapper struct {
+	// Words to look for in the product name and description,
+	// the last word also matches as a prefix
+	// in: query
+	// required: true
+	Q string `json:"q"`
+
+	// Maximum number of products to return, defaults to 50 and is capped at 200
+	// in: query
+	// required: false
+	Limit int `json:"limit"`
+}
+
+// swagger:parameters streamPrices streamPricesWebSocket
+type productStreamParamsWrapper struct {
+	// Currency the prices are streamed in
+	// in: query
+	// required: true
+	Currency string `json:"currency"`
+}
+
+// swagger:parameters exportProducts importProducts
+type productBulkParamsWrapper struct {
+	// File format, csv or ndjson. Imports fall back to the Content-Type
+	// of the body and both default to ndjson
+	// in: query
+	// required: false
+	Format string `json:"format"`
+}
+
+// swagger:parameters importProducts
+type productImportParamsWrapper struct {
+	// Products as CSV with a header row naming the columns, or as one JSON
+	// product per line. CSV needs name, price and sku columns, description
+	// and currency are optional
+	// in: body
+	// required: true
+	Body string
+}
+
+// swagger:parameters patchProduct
+type productPatchParamsWrapper struct {
+	// ETag returned by GET /products/{id}, when sent the patch is rejected
+	// with 412 if the product has changed since
+	// in: header
+	// required: false
+	IfMatch string `json:"If-Match"`
+
+	// A JSON Merge Patch object with the fields to change, null removes
+	// the description, or a JSON Patch array of operations
+	// in: body
+	// required: true
+	Body interface{}
+}
+
+// swagger:parameters listSingleProduct deleteProduct restoreProduct patchProduct
 type productIDParamsWrapper struct {
 	// The id of the product for which the operation relates
 	// in: path
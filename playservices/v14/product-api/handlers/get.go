@@ -1,31 +1,64 @@
 package handlers
 
 import (
+	"fmt"
+	"hash/fnv"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
 	"go.uber.org/zap"
 )
 
 // swagger:route GET /products products listProducts
-// Return a list of products from the database
+// Return a page of products from the database
 // responses:
 //	200: productsResponse
+//	400: errorResponse
 
-// ListAll handles GET requests and returns all current products
+// ListAll handles GET requests and returns a page of products
 func (p *ProductsHandler) ListAll(w http.ResponseWriter, r *http.Request) {
 
 	p.l.Info("Handle GET Products")
 
 	w.Header().Add("Content-Type", "application/json")
 
+	q, err := getProductQuery(r)
+	if err != nil {
+		p.l.Error("invalid product query", zap.Error(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	currencies, err := getCurrencies(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
 	curr := r.URL.Query().Get("currency")
 	// fetch the products from the datastore
-	lp, err := p.db.GetProducts(r.Context(), curr)
+	lp, err := p.db.GetProducts(r.Context(), q, curr, currencies...)
+	if unknownCurrency(w, err) {
+		p.l.Error("unknown currency", zap.Error(err))
+		return
+	}
+	if conversionUnavailable(w, err) {
+		err = nil
+	}
 	if err != nil {
 		p.l.Error("unable to fetch products", zap.Error(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
 	}
-	// serialize the list to JSON
+	// serialize the page to JSON
 	err = data.ToJSON(lp, w)
 	if err != nil {
 		http.Error(w, "Unable to marshal json", http.StatusInternalServerError)
@@ -35,7 +68,9 @@ func (p *ProductsHandler) ListAll(w http.ResponseWriter, r *http.Request) {
 // swagger:route GET /products/{id} products listSingleProduct
 // Return a list of products from the database
 // responses:
-//	200: productResponse
+//	200: productVersionResponse
+//	304: notModifiedResponse
+//	400: errorResponse
 //	404: errorResponse
 
 // ListSingle handles GET requests
@@ -45,8 +80,23 @@ func (p *ProductsHandler) ListSingleProduct(rw http.ResponseWriter, r *http.Requ
 
 	p.l.Info("[DEBUG]", zap.Any("get record id ", id))
 
+	currencies, err := getCurrencies(r)
+	if err != nil {
+		rw.Header().Add("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: err.Error()}, rw)
+		return
+	}
+
 	curr := r.URL.Query().Get("currency")
-	prod, err := p.db.GetProductByID(r.Context(), id, curr)
+	prod, err := p.db.GetProductByID(r.Context(), id, curr, currencies...)
+	if unknownCurrency(rw, err) {
+		p.l.Error("unknown currency", zap.Error(err))
+		return
+	}
+	if conversionUnavailable(rw, err) {
+		err = nil
+	}
 
 	switch err {
 	case nil:
@@ -65,9 +115,112 @@ func (p *ProductsHandler) ListSingleProduct(rw http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// the ETag starts with the product version, clients send it back in If-Match when updating
+	etag := productETag(prod, curr, currencies)
+	rw.Header().Set("ETag", etag)
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	err = data.ToJSON(prod, rw)
 	if err != nil {
 		// we should never be here but log the error just incase
 		p.l.Error("serializing product", zap.Error(err))
 	}
 }
+
+// productETag returns the ETag of prod as it was converted for the request.
+// Without a conversion it is the version, otherwise the version is followed
+// by a hash of the requested currencies and the converted prices so that it
+// changes with the exchange rates too
+func productETag(prod *data.Product, currency string, currencies []string) string {
+	if currency == "" && len(currencies) == 0 {
+		return formatETag(prod.Version)
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%s|%s %s", currency, strings.Join(currencies, ","), prod.Price, prod.Price.Currency)
+	for _, c := range currencies {
+		if m, ok := prod.Prices[c]; ok {
+			fmt.Fprintf(h, "|%s %s", m, c)
+		}
+	}
+
+	return strconv.Quote(fmt.Sprintf("%d-%x", prod.Version, h.Sum64()))
+}
+
+// etagMatches reports whether an If-None-Match value matches etag with the
+// weak comparison of RFC 9110: * matches anything, the value can be a comma
+// separated list and W/ is ignored
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(t), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// getProductQuery reads the paging, sorting and filter parameters from the URL
+func getProductQuery(r *http.Request) (data.ProductQuery, error) {
+	v := r.URL.Query()
+	q := data.ProductQuery{
+		Cursor:   v.Get("cursor"),
+		Sort:     v.Get("sort"),
+		MinPrice: v.Get("minPrice"),
+		MaxPrice: v.Get("maxPrice"),
+		SKU:      v.Get("sku"),
+		// currency only converts the prices, it does not filter them
+		PriceCurrency: v.Get("priceCurrency"),
+	}
+	if q.PriceCurrency != "" && !currencyCodeRe.MatchString(q.PriceCurrency) {
+		return q, fmt.Errorf("priceCurrency must be an ISO 4217 code such as USD")
+	}
+
+	if s := v.Get("limit"); s != "" {
+		var err error
+		if q.Limit, err = strconv.Atoi(s); err != nil || q.Limit < 1 {
+			return q, fmt.Errorf("limit must be a positive integer")
+		}
+	}
+
+	return q, q.Validate()
+}
+
+// maxCurrencies bounds the currencies parameter so one request can not fan
+// out into an unbounded number of rate lookups
+const maxCurrencies = 20
+
+var currencyCodeRe = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// getCurrencies reads the comma separated ISO codes of the currencies
+// parameter, duplicates are dropped
+func getCurrencies(r *http.Request) ([]string, error) {
+	v := r.URL.Query().Get("currencies")
+	if v == "" {
+		return nil, nil
+	}
+
+	var currencies []string
+	seen := map[string]bool{}
+	for _, c := range strings.Split(v, ",") {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if !currencyCodeRe.MatchString(c) {
+			return nil, fmt.Errorf("invalid currency %q in currencies", c)
+		}
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		currencies = append(currencies, c)
+	}
+
+	if len(currencies) > maxCurrencies {
+		return nil, fmt.Errorf("at most %d currencies can be requested", maxCurrencies)
+	}
+	return currencies, nil
+}
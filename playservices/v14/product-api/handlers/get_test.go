@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"github.com/gorilla/mux"
+)
+
+func TestGetUnknownCurrency(t *testing.T) {
+	ph, id := newRatesHandler(t, &rateService{rates: map[string]float64{"USD": 1.1}})
+
+	serve := func(h http.HandlerFunc, url string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		r = mux.SetURLVars(r, map[string]string{"id": id})
+		rw := httptest.NewRecorder()
+		h(rw, r)
+		return rw
+	}
+
+	for _, c := range []struct {
+		h   http.HandlerFunc
+		url string
+	}{
+		{ph.ListAll, "/products?currency=XYZ"},
+		{ph.ListAll, "/products?currencies=USD,XYZ"},
+		{ph.ListSingleProduct, "/products?id=" + id + "&currency=XYZ"},
+		{ph.Search, "/products/search?q=mocha&currency=XYZ"},
+	} {
+		rw := serve(c.h, c.url)
+		if rw.Code != http.StatusBadRequest || !strings.Contains(rw.Body.String(), "unknown currency XYZ") {
+			t.Fatalf("expected a 400 naming XYZ for %s, got %d %s", c.url, rw.Code, rw.Body.String())
+		}
+		if rw.Header().Get("X-Currency-Conversion") != "" {
+			t.Fatalf("expected an unknown currency not to look like an outage for %s", c.url)
+		}
+	}
+
+	rw := serve(ph.ListSingleProduct, "/products?id="+id+"&currency=USD")
+	if rw.Code != http.StatusOK || !strings.Contains(rw.Body.String(), `"amount":"3.41","currency":"USD"`) {
+		t.Fatalf("expected the price in USD, got %d %s", rw.Code, rw.Body.String())
+	}
+}
+
+func TestListPriceSortKeepsEveryProduct(t *testing.T) {
+	ph, _ := newMemoryHandler(t)
+
+	list := func(url string) (int, data.ProductPage) {
+		rw := httptest.NewRecorder()
+		ph.ListAll(rw, httptest.NewRequest(http.MethodGet, url, nil))
+		var page data.ProductPage
+		json.NewDecoder(rw.Body).Decode(&page)
+		return rw.Code, page
+	}
+
+	code, all := list("/products")
+	if code != http.StatusOK || len(all.Items) == 0 {
+		t.Fatalf("expected the products, got %d %+v", code, all)
+	}
+
+	// neither the price sort nor a conversion filter the products
+	for _, url := range []string{"/products?sort=price", "/products?sort=-price&currency=USD"} {
+		code, page := list(url)
+		if code != http.StatusOK || len(page.Items) != len(all.Items) {
+			t.Fatalf("expected all %d products for %s, got %d %d", len(all.Items), url, code, len(page.Items))
+		}
+	}
+
+	if code, page := list("/products?priceCurrency=USD"); code != http.StatusOK || len(page.Items) != 0 {
+		t.Fatalf("expected no products priced in USD, got %d %+v", code, page)
+	}
+	if code, _ := list("/products?priceCurrency=usd"); code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an invalid priceCurrency, got %d", code)
+	}
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+)
+
+// breakerStater is implemented by the stores which call the currency
+// service through a circuit breaker
+type breakerStater interface {
+	BreakerStats() data.BreakerStats
+}
+
+// subscriptionStater is implemented by the stores which convert prices with
+// rates streamed from the currency service
+type subscriptionStater interface {
+	SubscriptionState() data.SubscriptionState
+}
+
+// Health is the body returned by the health check
+type Health struct {
+	// Status is ok, or degraded when prices may be served from stale rates
+	Status string `json:"status"`
+	// CurrencySubscription is the state of the rate stream from the currency service
+	CurrencySubscription string `json:"currencySubscription,omitempty"`
+	// CurrencyBreaker is the circuit breaker around the currency service
+	CurrencyBreaker *data.BreakerStats `json:"currencyBreaker,omitempty"`
+}
+
+// Health handles GET requests for the health of the service. The service
+// keeps answering without the currency service so the status is always 200,
+// the body tells a degraded service apart
+func (p *ProductsHandler) Health(w http.ResponseWriter, r *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+
+	h := &Health{Status: "ok"}
+	if s, ok := p.db.(subscriptionStater); ok {
+		st := s.SubscriptionState()
+		h.CurrencySubscription = st.String()
+		if st != data.SubscriptionConnected {
+			h.Status = "degraded"
+		}
+	}
+
+	if b, ok := p.db.(breakerStater); ok {
+		bs := b.BreakerStats()
+		h.CurrencyBreaker = &bs
+		if bs.State != data.BreakerClosed.String() {
+			h.Status = "degraded"
+		}
+	}
+
+	data.ToJSON(h, w)
+}
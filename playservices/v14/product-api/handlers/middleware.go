@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"regexp"
 
 	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
 	"go.uber.org/zap"
@@ -13,9 +14,12 @@ func (p *ProductsHandler) MiddlewareValidateProduct(next http.Handler) http.Hand
 		func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Add("Content-Type", "application/json")
 			var prod []*data.Product
-			err := data.FromJSON(&prod, r.Body)
+			err := data.FromJSON(&prod, http.MaxBytesReader(w, r.Body, maxBodySize))
 			if err != nil {
 				p.l.Error("error deserealizing product", zap.Error(err))
+				if bodyTooLarge(w, err) {
+					return
+				}
 				http.Error(w, "error reading product", http.StatusBadRequest)
 				return
 			}
@@ -44,3 +48,24 @@ func (p *ProductsHandler) MiddlewareValidateProduct(next http.Handler) http.Hand
 		},
 	)
 }
+
+// requestIDRe limits the request ids taken from clients to a safe length
+// and character set
+var requestIDRe = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// MiddlewareRequestID gives every request an id, taken from the X-Request-Id
+// header when it has one, returns it in the response and passes it on to
+// the currency service
+func (p *ProductsHandler) MiddlewareRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(data.RequestIDHeader)
+			if !requestIDRe.MatchString(id) {
+				id = data.NewRequestID()
+			}
+			w.Header().Set(data.RequestIDHeader, id)
+
+			next.ServeHTTP(w, r.WithContext(data.WithRequestID(r.Context(), id)))
+		},
+	)
+}
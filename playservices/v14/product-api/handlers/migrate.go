@@ -1,19 +1,35 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
 
+// docsMigrator is implemented by the stores which can be seeded with the sample products
+type docsMigrator interface {
+	MigrateDocs(ctx context.Context) (*mongo.InsertManyResult, error)
+}
+
 func (p *ProductsHandler) MigrateDocs(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Content-Type", "application/json")
 	p.l.Info("Handle GET Products")
-	res, err := p.db.MigrateDocs(r.Context())
+
+	m, ok := p.db.(docsMigrator)
+	if !ok {
+		w.WriteHeader(http.StatusNotImplemented)
+		data.ToJSON(&GenericError{Message: "migration is not supported by this store"}, w)
+		return
+	}
+
+	res, err := m.MigrateDocs(r.Context())
 	if err != nil {
 		p.l.Error("error migrating docs", zap.Error(err))
 		http.Error(w, "error migrating docs", http.StatusInternalServerError)
+		return
 	}
 	//w.WriteHeader(http.StatusOK)
 	err = data.ToJSON(res.InsertedIDs, w)
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+)
+
+// swagger:route PATCH /products/{id} products patchProduct
+// Change some of the fields of a product with a JSON Merge Patch (RFC 7396)
+// or a JSON Patch (RFC 6902), chosen by the Content-Type of the request
+//
+// Consumes:
+// - application/merge-patch+json
+// - application/json-patch+json
+//
+// responses:
+//	204: productPatchedResponse
+//  400: errorResponse
+//  404: errorResponse
+//  409: errorConflict
+//  412: errorResponse
+//  413: errorResponse
+//  415: errorResponse
+//  422: errorValidation
+
+// Patch handles PATCH requests, the patch is applied to the stored product
+// and only the fields it changed are written back
+func (p *ProductsHandler) Patch(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle PATCH Product")
+
+	w.Header().Add("Content-Type", "application/json")
+
+	id := p.getProductID(r)
+
+	mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mt != mergePatchContentType && mt != jsonPatchContentType {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		data.ToJSON(&GenericError{Message: fmt.Sprintf("Content-Type must be %s or %s", mergePatchContentType, jsonPatchContentType)}, w)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		p.l.Error("error reading patch", zap.Error(err))
+		if bodyTooLarge(w, err) {
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: "error reading patch"}, w)
+		return
+	}
+
+	cur, err := p.db.GetProductByID(r.Context(), id, "")
+	switch err {
+	case nil:
+	case data.ErrProductNotFound:
+		p.l.Error("product not found in patch", zap.Error(err))
+		w.WriteHeader(http.StatusNotFound)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	default:
+		p.l.Error("error fetching product", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	// If-Match is optional, without it the patch applies to the version
+	// read above and a concurrent write in between still fails with 412
+	version := cur.Version
+	if im := r.Header.Get("If-Match"); im != "" {
+		v, err := parseETag(im)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+		if v != version {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			data.ToJSON(&GenericError{Message: data.ErrVersionMismatch.Error()}, w)
+			return
+		}
+	}
+
+	doc, err := json.Marshal(cur)
+	if err != nil {
+		p.l.Error("error serializing product", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	var patched []byte
+	if mt == mergePatchContentType {
+		patched, err = jsonpatch.MergePatch(doc, body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+	} else {
+		patch, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+		// a well formed patch which can not be applied, such as a failed
+		// test operation, conflicts with the current state of the product
+		patched, err = patch.Apply(doc)
+		if err != nil {
+			w.WriteHeader(http.StatusConflict)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+	}
+
+	np := &data.Product{}
+	if err := json.Unmarshal(patched, np); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	if errs := p.v.Validate(np); len(errs) != 0 {
+		p.l.Error("validating patched product", zap.Any("", errs))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		data.ToJSON(&ValidationError{Messages: errs.Errors()}, w)
+		return
+	}
+
+	// id, version and the trash fields are ignored, only the client
+	// editable fields which actually changed are written
+	fields := data.ChangedFields(cur, np)
+	if len(fields) > 0 {
+		version, err = p.db.PatchProduct(r.Context(), np, fields, id, version)
+		switch err {
+		case nil:
+		case data.ErrProductNotFound:
+			w.WriteHeader(http.StatusNotFound)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		case data.ErrVersionMismatch:
+			p.l.Error("stale product version in patch", zap.Error(err))
+			w.WriteHeader(http.StatusPreconditionFailed)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		default:
+			if writeSKUConflict(w, err, nil) {
+				p.l.Error("duplicate sku in patch", zap.Error(err))
+				return
+			}
+			p.l.Error("error patching product", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			return
+		}
+	}
+
+	w.Header().Set("ETag", formatETag(version))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+// patch calls Patch for id with the given Content-Type, If-Match and body
+func patch(ph *ProductsHandler, id string, contentType string, ifMatch string, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPatch, "/products/"+id, strings.NewReader(body))
+	r = mux.SetURLVars(r, map[string]string{"id": id})
+	r.Header.Set("Content-Type", contentType)
+	if ifMatch != "" {
+		r.Header.Set("If-Match", ifMatch)
+	}
+	rw := httptest.NewRecorder()
+	ph.Patch(rw, r)
+	return rw
+}
+
+func TestPatchMergePatch(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	rw := patch(ph, id, mergePatchContentType, `"1"`, `{"description":"with cocoa","price":{"amount":"3.40","currency":"EUR"}}`)
+	if rw.Code != http.StatusNoContent || rw.Header().Get("ETag") != `"2"` {
+		t.Fatalf("expected 204 with ETag \"2\", got %d %q %s", rw.Code, rw.Header().Get("ETag"), rw.Body.String())
+	}
+
+	// the fields which were not in the patch are kept
+	body := get(ph, id, "").Body.String()
+	for _, s := range []string{`"name":"Mocha"`, `"description":"with cocoa"`, `"amount":"3.40"`, `"sku":"abc-def-ghi"`} {
+		if !strings.Contains(body, s) {
+			t.Fatalf("expected %s in %s", s, body)
+		}
+	}
+
+	// null removes the description, without If-Match the current version is patched
+	if rw := patch(ph, id, mergePatchContentType+"; charset=utf-8", "", `{"description":null}`); rw.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 without If-Match, got %d %s", rw.Code, rw.Body.String())
+	}
+	if body := get(ph, id, "").Body.String(); !strings.Contains(body, `"description":""`) {
+		t.Fatalf("expected the description to be removed, got %s", body)
+	}
+}
+
+func TestPatchJSONPatch(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	ops := `[{"op":"test","path":"/name","value":"Mocha"},{"op":"replace","path":"/name","value":"Latte"}]`
+	if rw := patch(ph, id, jsonPatchContentType, `"1"`, ops); rw.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d %s", rw.Code, rw.Body.String())
+	}
+	if body := get(ph, id, "").Body.String(); !strings.Contains(body, `"name":"Latte"`) {
+		t.Fatalf("expected the name to be replaced, got %s", body)
+	}
+
+	// the test operation now fails against the stored product
+	rw := patch(ph, id, jsonPatchContentType, "", ops)
+	if rw.Code != http.StatusConflict {
+		t.Fatalf("expected 409 for a failed test operation, got %d %s", rw.Code, rw.Body.String())
+	}
+	if rw = get(ph, id, ""); rw.Header().Get("ETag") != `"2"` {
+		t.Fatalf("expected the failed patch not to be written, got ETag %q", rw.Header().Get("ETag"))
+	}
+}
+
+func TestPatchRejected(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	tests := []struct {
+		name        string
+		contentType string
+		ifMatch     string
+		body        string
+		code        int
+	}{
+		{"plain json", "application/json", `"1"`, `{"name":"Latte"}`, http.StatusUnsupportedMediaType},
+		{"stale If-Match", mergePatchContentType, `"0"`, `{"name":"Latte"}`, http.StatusPreconditionFailed},
+		{"invalid product", mergePatchContentType, `"1"`, `{"name":"","sku":"ABC123"}`, http.StatusUnprocessableEntity},
+		{"malformed patch", jsonPatchContentType, `"1"`, `{"op":"replace"}`, http.StatusBadRequest},
+		{"body too large", mergePatchContentType, `"1"`, `{"description":"` + strings.Repeat("a", maxBodySize) + `"}`, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		rw := patch(ph, id, tt.contentType, tt.ifMatch, tt.body)
+		if rw.Code != tt.code {
+			t.Fatalf("%s: expected %d, got %d %s", tt.name, tt.code, rw.Code, rw.Body.String())
+		}
+	}
+
+	// none of them changed the product
+	rw := get(ph, id, "")
+	if rw.Header().Get("ETag") != `"1"` || !strings.Contains(rw.Body.String(), `"name":"Mocha"`) {
+		t.Fatalf("expected the product to be unchanged, got %q %s", rw.Header().Get("ETag"), rw.Body.String())
+	}
+}
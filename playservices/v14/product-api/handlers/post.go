@@ -13,6 +13,8 @@ import (
 //
 // responses:
 //	200: productResponse
+//  409: errorConflict
+//  413: errorResponse
 //  422: errorValidation
 //  501: errorResponse
 
@@ -24,16 +26,16 @@ func (p *ProductsHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	p.l.Info("inserting a new product", zap.Any("", prod))
 
-	var docs []interface{}
-	for _, p := range prod {
-		docs = append(docs, p)
+	ids, err := p.db.AddProduct(r.Context(), prod)
+	if writeSKUConflict(w, err, ids) {
+		p.l.Error("duplicate sku in create", zap.Error(err))
+		return
 	}
-	res, err := p.db.AddProduct(r.Context(), docs)
 	if err != nil {
 		p.l.Error("error creating a new product", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
 		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
 	}
-	data.ToJSON(fmt.Sprintf("inserted id: %s", res), w)
-
-	w.WriteHeader(http.StatusOK)
+	data.ToJSON(fmt.Sprintf("inserted id: %s", ids), w)
 }
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCreateSKUConflict(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	body := `[{"name":"Latte","price":{"amount":"2.45","currency":"EUR"},"sku":"abc-def-jkl"},` +
+		`{"name":"Mocha again","price":{"amount":"3.10","currency":"EUR"},"sku":"abc-def-ghi"}]`
+	r := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	rw := httptest.NewRecorder()
+	ph.MiddlewareValidateProduct(http.HandlerFunc(ph.Create)).ServeHTTP(rw, r)
+
+	var ce ConflictError
+	if err := json.NewDecoder(rw.Body).Decode(&ce); err != nil {
+		t.Fatal(err)
+	}
+	if rw.Code != http.StatusConflict || len(ce.Conflicts) != 1 || len(ce.InsertedIDs) != 1 {
+		t.Fatalf("expected a 409 with one conflict and one inserted id, got %d %+v", rw.Code, ce)
+	}
+	if c := ce.Conflicts[0]; c.Item != 1 || c.SKU != "abc-def-ghi" || c.ExistingID != id {
+		t.Fatalf("expected the second product to conflict with %s, got %+v", id, c)
+	}
+
+	// the product which did not conflict was stored
+	if rw := get(ph, ce.InsertedIDs[0], ""); rw.Code != http.StatusOK {
+		t.Fatalf("expected the inserted product to be readable, got %d", rw.Code)
+	}
+}
+
+func TestRestoreSKUConflict(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+	oid, _ := primitive.ObjectIDFromHex(id)
+	if err := ph.db.DeleteProduct(context.Background(), oid, "admin"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the SKU is taken while the product is in the trash
+	body := `[{"name":"White Mocha","price":{"amount":"3.40","currency":"EUR"},"sku":"abc-def-ghi"}]`
+	r := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	rw := httptest.NewRecorder()
+	ph.MiddlewareValidateProduct(http.HandlerFunc(ph.Create)).ServeHTTP(rw, r)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected the SKU in the trash to be free, got %d %s", rw.Code, rw.Body.String())
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/products/"+id+"/restore", nil)
+	r = mux.SetURLVars(r, map[string]string{"id": id})
+	rw = httptest.NewRecorder()
+	ph.Restore(rw, r)
+
+	var ce ConflictError
+	if err := json.NewDecoder(rw.Body).Decode(&ce); err != nil {
+		t.Fatal(err)
+	}
+	if rw.Code != http.StatusConflict || len(ce.Conflicts) != 1 || ce.Conflicts[0].SKU != "abc-def-ghi" {
+		t.Fatalf("expected a 409 for the taken SKU, got %d %+v", rw.Code, ce)
+	}
+}
+
+func TestCreateBodyTooLarge(t *testing.T) {
+	ph, _ := newMemoryHandler(t)
+
+	body := `[{"name":"Latte","description":"` + strings.Repeat("a", maxBodySize) + `","price":{"amount":"2.45","currency":"EUR"},"sku":"abc-def-jkl"}]`
+	r := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	rw := httptest.NewRecorder()
+	ph.MiddlewareValidateProduct(http.HandlerFunc(ph.Create)).ServeHTTP(rw, r)
+
+	if rw.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected 413 for a body over %d bytes, got %d", maxBodySize, rw.Code)
+	}
+}
@@ -16,6 +16,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -45,11 +46,24 @@ var logKey LoggerKeyType = "LOGGER"
 // ErrInvalidProductPath is an error message when the product path is not valid
 var ErrInvalidProductPath = fmt.Errorf("invalid Path, path should be /products/[id]")
 
+// maxBodySize is the largest request body the write handlers read, the
+// import streams its body and is not limited
+const maxBodySize = 1 << 20
+
 // GenericError is a generic error message returned by a server
 type GenericError struct {
 	Message string `json:"message"`
 }
 
+// ConflictError is returned when products could not be written because
+// their SKU is already used by another product
+type ConflictError struct {
+	Message   string             `json:"message"`
+	Conflicts []data.SKUConflict `json:"conflicts"`
+	// InsertedIDs are the ids of the products of a bulk create which did not conflict
+	InsertedIDs []string `json:"insertedIds,omitempty"`
+}
+
 // ValidationError is a collection of validation error messages
 type ValidationError struct {
 	Messages []string `json:"messages"`
@@ -60,12 +74,15 @@ type ProductsHandler struct {
 	l  *zap.Logger
 	v  *data.Validation
 	cc protos.CurrencyClient
-	db *data.ProductsDB
+	db data.ProductStore
+
+	// streams holds a token for every open price stream
+	streams chan struct{}
 }
 
 // NewProducts returns a new products handler with the given logger
-func NewProducts(l *zap.Logger, v *data.Validation, cc protos.CurrencyClient, db *data.ProductsDB) *ProductsHandler {
-	return &ProductsHandler{l, v, cc, db}
+func NewProducts(l *zap.Logger, v *data.Validation, cc protos.CurrencyClient, db data.ProductStore) *ProductsHandler {
+	return &ProductsHandler{l, v, cc, db, make(chan struct{}, maxStreams)}
 }
 
 func loggableRequest(r *http.Request) map[string]interface{} {
@@ -94,6 +111,64 @@ func (ph *ProductsHandler) getProductID(r *http.Request) primitive.ObjectID {
 	return id
 }
 
+// writeSKUConflict writes a 409 with the conflicts when err is an
+// ErrDuplicateSKU and reports whether it did
+func writeSKUConflict(w http.ResponseWriter, err error, inserted []string) bool {
+	var dup *data.ErrDuplicateSKU
+	if !errors.As(err, &dup) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusConflict)
+	data.ToJSON(&ConflictError{Message: dup.Error(), Conflicts: dup.Conflicts, InsertedIDs: inserted}, w)
+	return true
+}
+
+// conversionUnavailable flags the response when the prices could not be
+// converted and reports whether err was only that
+func conversionUnavailable(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, data.ErrConversionUnavailable) {
+		return false
+	}
+
+	w.Header().Set("X-Currency-Conversion", "unavailable")
+	return true
+}
+
+// unknownCurrency writes a 400 when err is an ErrUnknownCurrency and reports
+// whether it did, asking for a currency which does not exist is the client's
+// mistake and not an outage of the currency service
+func unknownCurrency(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, data.ErrUnknownCurrency) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusBadRequest)
+	data.ToJSON(&GenericError{Message: err.Error()}, w)
+	return true
+}
+
+// bodyTooLarge writes a 413 when err is from reading a body over
+// maxBodySize and reports whether it did
+func bodyTooLarge(w http.ResponseWriter, err error) bool {
+	var mbe *http.MaxBytesError
+	if !errors.As(err, &mbe) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	data.ToJSON(&GenericError{Message: fmt.Sprintf("request body is larger than %d bytes", mbe.Limit)}, w)
+	return true
+}
+
+// getUser returns who made the request, as set by the X-User header
+func getUser(r *http.Request) string {
+	if u := r.Header.Get("X-User"); u != "" {
+		return u
+	}
+	return "unknown"
+}
+
 func InjectRequest(ctx context.Context, r *http.Request) context.Context {
 	return context.WithValue(ctx, requestKey, r)
 }
@@ -3,6 +3,8 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,8 +16,13 @@ import (
 //
 // responses:
 //	201: noContentResponse
+//  400: errorResponse
 //  404: errorResponse
+//  409: errorConflict
+//  412: errorResponse
+//  413: errorResponse
 //  422: errorValidation
+//  428: errorResponse
 
 // Update handles PUT requests to update products
 func (p *ProductsHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -31,13 +38,35 @@ func (p *ProductsHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		http.Error(w, "invalid object id", http.StatusInternalServerError)
 		p.l.Error("invalid object id", zap.Error(err))
+		return
+	}
+
+	if len(prod) != 1 {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: "exactly one product must be sent in a PUT"}, w)
+		return
+	}
+
+	// the client must tell us which version it read so that concurrent
+	// edits are detected instead of silently overwritten
+	im := r.Header.Get("If-Match")
+	if im == "" {
+		w.WriteHeader(http.StatusPreconditionRequired)
+		data.ToJSON(&GenericError{Message: "If-Match header with the product ETag is required"}, w)
+		return
+	}
+	version, err := parseETag(im)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
 	}
 
 	p.l.Info("product id", zap.Any(string(productKey), i))
 	p.l.Info("Handle PUT Products", zap.Any(string(logKey), ctx.Value(logKey)))
 	p.l.Info("product from context", zap.Any(string(productKey), ctx.Value(productKey)))
 
-	res, err := p.db.UpdateProduct(r.Context(), prod, i)
+	nv, err := p.db.UpdateProduct(r.Context(), prod[0], i, version)
 
 	if err != nil {
 		switch err {
@@ -45,18 +74,44 @@ func (p *ProductsHandler) Update(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, fmt.Sprintf("product of id: %s not found in put ", i), http.StatusNotFound)
 			p.l.Error("product not found in put", zap.Error(err))
 			return
+		case data.ErrVersionMismatch:
+			w.WriteHeader(http.StatusPreconditionFailed)
+			data.ToJSON(&GenericError{Message: err.Error()}, w)
+			p.l.Error("stale product version in put", zap.Error(err))
+			return
 		default:
+			if writeSKUConflict(w, err, nil) {
+				p.l.Error("duplicate sku in put", zap.Error(err))
+				return
+			}
 			http.Error(w, "product not found in put", http.StatusInternalServerError)
 			p.l.Error("product not found in put", zap.Error(err))
 			return
 		}
 	}
 
-	err = data.ToJSON(res, w)
-	if err != nil {
-		p.l.Error("error serializing the contents", zap.Error(err))
-	}
+	w.Header().Set("ETag", formatETag(nv))
 
 	// write the no content success header
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// formatETag returns the strong ETag for a product version
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag returns the product version from an If-Match value, the ETag of
+// a converted product has the version before a dash
+func parseETag(etag string) (int64, error) {
+	s, err := strconv.Unquote(etag)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ETag %s", etag)
+	}
+	s, _, _ = strings.Cut(s, "-")
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ETag %s", etag)
+	}
+	return v, nil
+}
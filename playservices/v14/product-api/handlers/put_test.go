@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// newMemoryHandler returns a handler on an in-memory store holding one product
+func newMemoryHandler(t *testing.T) (*ProductsHandler, string) {
+	db := data.GetMemoryProductsDB(nil, zap.NewNop())
+	ids, err := db.AddProduct(context.Background(), []*data.Product{{Name: "Mocha", Price: data.MustMoney("3.10", "EUR"), SKU: "abc-def-ghi"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewProducts(zap.NewNop(), data.NewValidation(), nil, db), ids[0]
+}
+
+// get calls ListSingleProduct for id with the given If-None-Match
+func get(ph *ProductsHandler, id string, ifNoneMatch string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/products?id="+id, nil)
+	r = mux.SetURLVars(r, map[string]string{"id": id})
+	if ifNoneMatch != "" {
+		r.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	rw := httptest.NewRecorder()
+	ph.ListSingleProduct(rw, r)
+	return rw
+}
+
+// put calls Update, through the validation middleware, for id with the given If-Match
+func put(ph *ProductsHandler, id string, ifMatch string, name string) *httptest.ResponseRecorder {
+	body := `[{"name":"` + name + `","price":{"amount":"3.20","currency":"EUR"},"sku":"abc-def-ghi"}]`
+	r := httptest.NewRequest(http.MethodPut, "/products?id="+id+"&currency=EUR", strings.NewReader(body))
+	if ifMatch != "" {
+		r.Header.Set("If-Match", ifMatch)
+	}
+	rw := httptest.NewRecorder()
+	ph.MiddlewareValidateProduct(http.HandlerFunc(ph.Update)).ServeHTTP(rw, r)
+	return rw
+}
+
+func TestGetETag(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	rw := get(ph, id, "")
+	if rw.Code != http.StatusOK || rw.Header().Get("ETag") != `"1"` {
+		t.Fatalf("expected 200 with ETag \"1\", got %d %q", rw.Code, rw.Header().Get("ETag"))
+	}
+
+	rw = get(ph, id, `"1"`)
+	if rw.Code != http.StatusNotModified || rw.Body.Len() != 0 {
+		t.Fatalf("expected an empty 304 for the current ETag, got %d %q", rw.Code, rw.Body.String())
+	}
+
+	if rw = get(ph, id, `"0"`); rw.Code != http.StatusOK {
+		t.Fatalf("expected 200 for an old ETag, got %d", rw.Code)
+	}
+}
+
+func TestGetETagConversion(t *testing.T) {
+	rs := &rateService{rates: map[string]float64{"USD": 1.1, "GBP": 0.85}}
+	ph, id := newRatesHandler(t, rs)
+
+	getIn := func(query string, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/products?id="+id+"&"+query, nil)
+		r = mux.SetURLVars(r, map[string]string{"id": id})
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rw := httptest.NewRecorder()
+		ph.ListSingleProduct(rw, r)
+		return rw
+	}
+
+	usd := getIn("currency=USD", "").Header().Get("ETag")
+	if usd == `"1"` || !strings.HasPrefix(usd, `"1-`) {
+		t.Fatalf("expected the ETag of a converted price to add to the version, got %s", usd)
+	}
+	for _, inm := range []string{usd, "W/" + usd, `"0", ` + usd, "*"} {
+		if rw := getIn("currency=USD", inm); rw.Code != http.StatusNotModified {
+			t.Fatalf("expected 304 for If-None-Match %s, got %d", inm, rw.Code)
+		}
+	}
+
+	// the same version in another currency is another representation
+	if rw := getIn("currency=GBP", usd); rw.Code != http.StatusOK {
+		t.Fatalf("expected 200 for GBP with the USD ETag, got %d", rw.Code)
+	}
+	if rw := getIn("currencies=GBP", usd); rw.Code != http.StatusOK {
+		t.Fatalf("expected 200 for currencies=GBP with the USD ETag, got %d", rw.Code)
+	}
+
+	// so is the same currency after the rate has changed
+	waitFor(t, "the new USD rate", func() bool {
+		rs.push(protos.Currencies_USD, 1.2)
+		return strings.Contains(getIn("currency=USD", "").Body.String(), `"amount":"3.72"`)
+	})
+	rw := getIn("currency=USD", usd)
+	if rw.Code != http.StatusOK || rw.Header().Get("ETag") == usd {
+		t.Fatalf("expected 200 with a new ETag after the rate changed, got %d %s", rw.Code, rw.Header().Get("ETag"))
+	}
+
+	// the ETag of a converted product can still be sent in If-Match
+	if rw := put(ph, id, rw.Header().Get("ETag"), "Latte"); rw.Code != http.StatusNoContent {
+		t.Fatalf("expected the converted ETag to be accepted in If-Match, got %d", rw.Code)
+	}
+}
+
+func TestUpdateIfMatch(t *testing.T) {
+	ph, id := newMemoryHandler(t)
+
+	if rw := put(ph, id, "", "Latte"); rw.Code != http.StatusPreconditionRequired {
+		t.Fatalf("expected 428 without If-Match, got %d", rw.Code)
+	}
+
+	rw := put(ph, id, `"1"`, "Latte")
+	if rw.Code != http.StatusNoContent || rw.Header().Get("ETag") != `"2"` {
+		t.Fatalf("expected 204 with ETag \"2\", got %d %q", rw.Code, rw.Header().Get("ETag"))
+	}
+
+	// a second writer which read version 1 is turned away
+	if rw := put(ph, id, `"1"`, "Flat White"); rw.Code != http.StatusPreconditionFailed {
+		t.Fatalf("expected 412 for a stale If-Match, got %d", rw.Code)
+	}
+
+	rw = get(ph, id, "")
+	if rw.Header().Get("ETag") != `"2"` || !strings.Contains(rw.Body.String(), `"name":"Latte"`) {
+		t.Fatalf("expected the first update to be kept, got %q %s", rw.Header().Get("ETag"), rw.Body.String())
+	}
+}
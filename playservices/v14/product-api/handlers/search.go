@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.uber.org/zap"
+)
+
+// swagger:route GET /products/search products searchProducts
+// Search the products by name and description, best match first
+// responses:
+//	200: productsSearchResponse
+//	400: errorResponse
+
+// Search handles GET requests and returns the products matching the q parameter
+func (p *ProductsHandler) Search(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle GET Products search")
+
+	w.Header().Add("Content-Type", "application/json")
+
+	v := r.URL.Query()
+	text := v.Get("q")
+	if text == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		data.ToJSON(&GenericError{Message: "missing search query q"}, w)
+		return
+	}
+
+	limit := 0
+	if s := v.Get("limit"); s != "" {
+		var err error
+		if limit, err = strconv.Atoi(s); err != nil || limit < 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			data.ToJSON(&GenericError{Message: "limit must be a positive integer"}, w)
+			return
+		}
+	}
+
+	lp, err := p.db.SearchProducts(r.Context(), text, limit, v.Get("currency"))
+	if unknownCurrency(w, err) {
+		p.l.Error("unknown currency", zap.Error(err))
+		return
+	}
+	if conversionUnavailable(w, err) {
+		err = nil
+	}
+	if err != nil {
+		p.l.Error("unable to search products", zap.Error(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	err = data.ToJSON(lp, w)
+	if err != nil {
+		p.l.Error("serializing products", zap.Error(err))
+	}
+}
@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxStreams caps the price streams open at the same time
+	maxStreams = 100
+	// streamWriteTimeout is how long a client can take to accept an event,
+	// a client which falls further behind is disconnected
+	streamWriteTimeout = 10 * time.Second
+)
+
+// streamHeartbeat is how often an idle stream is written to, so that
+// proxies keep it open and dead clients are noticed
+var streamHeartbeat = 15 * time.Second
+
+// rateWatcher is implemented by the stores which are told about rate updates
+type rateWatcher interface {
+	WatchRates(destination string) (<-chan data.RateUpdate, func())
+}
+
+// PriceEvent is pushed on a price stream whenever the prices change
+type PriceEvent struct {
+	// Rate is the update which changed the prices, it is not set on the
+	// first event of a stream
+	Rate *data.RateUpdate `json:"rate,omitempty"`
+	// Converted is false when the prices could not be converted and are in
+	// their stored currency
+	Converted bool `json:"converted"`
+	// Products is the page of products with their prices
+	Products *data.ProductPage `json:"products"`
+}
+
+// priceSink writes events to one client
+type priceSink interface {
+	send(ev *PriceEvent) error
+	heartbeat() error
+}
+
+// swagger:route GET /products/stream products streamPrices
+// Stream the products with their prices in a currency as Server-Sent Events.
+// A prices event is sent straight away and again every time a rate into the
+// currency changes, a comment is sent as heartbeat when nothing changes
+//
+// Produces:
+// - text/event-stream
+//
+// responses:
+//	200: priceStreamResponse
+//	400: errorResponse
+//	503: errorResponse
+
+// Stream handles GET requests for a Server-Sent Events price stream
+func (p *ProductsHandler) Stream(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle GET Products stream")
+
+	q, currency, watcher, ok := p.openStream(w, r)
+	if !ok {
+		return
+	}
+	defer func() { <-p.streams }()
+
+	// the stream outlives the server write timeout, every event sets its own deadline
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	p.streamPrices(r.Context(), &sseSink{w, rc}, q, currency, watcher)
+}
+
+// swagger:route GET /products/stream/ws products streamPricesWebSocket
+// Stream the products with their prices in a currency over a WebSocket.
+// Every message is a JSON price event, heartbeats are ping frames
+//
+// responses:
+//	101: priceStreamResponse
+//	400: errorResponse
+//	503: errorResponse
+
+// StreamWebSocket handles GET requests for a WebSocket price stream
+func (p *ProductsHandler) StreamWebSocket(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle GET Products stream websocket")
+
+	q, currency, watcher, ok := p.openStream(w, r)
+	if !ok {
+		return
+	}
+	defer func() { <-p.streams }()
+
+	c, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already written the error response
+		p.l.Error("unable to upgrade to websocket", zap.Error(err))
+		return
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// the client only sends control frames, reading them handles the pongs
+	// and tells us when it goes away
+	c.SetReadLimit(512)
+	c.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	c.SetPongHandler(func(string) error {
+		return c.SetReadDeadline(time.Now().Add(2 * streamHeartbeat))
+	})
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := c.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	p.streamPrices(ctx, &wsSink{c}, q, currency, watcher)
+}
+
+var upgrader = websocket.Upgrader{
+	// the API is open to every origin, see the CORS handler in main
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// openStream validates a stream request and takes one of the stream slots,
+// it writes the error response and returns false when the stream can not
+// be opened. The caller must release the slot
+func (p *ProductsHandler) openStream(w http.ResponseWriter, r *http.Request) (data.ProductQuery, string, rateWatcher, bool) {
+	fail := func(code int, msg string) (data.ProductQuery, string, rateWatcher, bool) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(code)
+		data.ToJSON(&GenericError{Message: msg}, w)
+		return data.ProductQuery{}, "", nil, false
+	}
+
+	q, err := getProductQuery(r)
+	if err != nil {
+		return fail(http.StatusBadRequest, err.Error())
+	}
+	currency := r.URL.Query().Get("currency")
+	if !currencyCodeRe.MatchString(currency) {
+		return fail(http.StatusBadRequest, "currency must be an ISO 4217 code such as USD")
+	}
+
+	watcher, ok := p.db.(rateWatcher)
+	if !ok {
+		return fail(http.StatusNotImplemented, "price streams are not supported by this store")
+	}
+
+	select {
+	case p.streams <- struct{}{}:
+	default:
+		w.Header().Set("Retry-After", strconv.Itoa(int(streamHeartbeat.Seconds())))
+		return fail(http.StatusServiceUnavailable, "too many price streams, try again later")
+	}
+
+	return q, currency, watcher, true
+}
+
+// streamPrices sends the prices of the page selected by q, and sends them
+// again whenever a rate into currency changes, until ctx is done or the
+// client can not keep up
+func (p *ProductsHandler) streamPrices(ctx context.Context, s priceSink, q data.ProductQuery, currency string, watcher rateWatcher) {
+	updates, stop := watcher.WatchRates(currency)
+	defer stop()
+
+	send := func(u *data.RateUpdate) error {
+		lp, err := p.db.GetProducts(ctx, q, currency)
+		converted := !errors.Is(err, data.ErrConversionUnavailable)
+		if converted && err != nil {
+			return err
+		}
+		return s.send(&PriceEvent{Rate: u, Converted: converted, Products: lp})
+	}
+
+	if err := send(nil); err != nil {
+		p.l.Error("closing price stream", zap.Error(err))
+		return
+	}
+
+	t := time.NewTicker(streamHeartbeat)
+	defer t.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-updates:
+			err = send(&u)
+		case <-t.C:
+			err = s.heartbeat()
+		}
+
+		if err != nil {
+			p.l.Error("closing price stream", zap.Error(err))
+			return
+		}
+	}
+}
+
+// sseSink writes Server-Sent Events
+type sseSink struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (s *sseSink) send(ev *PriceEvent) error {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return s.write(fmt.Sprintf("event: prices\ndata: %s\n\n", b))
+}
+
+func (s *sseSink) heartbeat() error {
+	return s.write(": heartbeat\n\n")
+}
+
+func (s *sseSink) write(msg string) error {
+	s.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if _, err := fmt.Fprint(s.w, msg); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// wsSink writes WebSocket messages
+type wsSink struct {
+	c *websocket.Conn
+}
+
+func (s *wsSink) send(ev *PriceEvent) error {
+	s.c.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	return s.c.WriteJSON(ev)
+}
+
+func (s *wsSink) heartbeat() error {
+	return s.c.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout))
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	protos "github.com/AmitSuresh/playground/playservices/v14/currency/protos/currency"
+	"github.com/gorilla/websocket"
+)
+
+// shortHeartbeat makes the streams opened by the test send a heartbeat
+// every 50ms
+func shortHeartbeat(t *testing.T) {
+	hb := streamHeartbeat
+	streamHeartbeat = 50 * time.Millisecond
+	t.Cleanup(func() { streamHeartbeat = hb })
+}
+
+// priceOf returns the price of the product id in ev
+func priceOf(t *testing.T, ev *PriceEvent, id string) string {
+	t.Helper()
+	for _, p := range ev.Products.Items {
+		if p.ID == id {
+			return p.Price.String() + " " + p.Price.Currency
+		}
+	}
+	t.Fatalf("expected product %s in the event", id)
+	return ""
+}
+
+// readSSE reads the next message from a Server-Sent Events stream, it
+// returns the event with the heartbeat set to false, or only a heartbeat
+func readSSE(t *testing.T, br *bufio.Reader) (*PriceEvent, bool) {
+	t.Helper()
+	var ev *PriceEvent
+	heartbeat := false
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return ev, heartbeat
+		case line == ": heartbeat":
+			heartbeat = true
+		case strings.HasPrefix(line, "data: "):
+			ev = &PriceEvent{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), ev); err != nil {
+				t.Fatal(err)
+			}
+		case line != "event: prices":
+			t.Fatalf("unexpected line %q", line)
+		}
+	}
+}
+
+func TestStreamSSE(t *testing.T) {
+	shortHeartbeat(t)
+	rs := &rateService{rates: map[string]float64{"USD": 1.1}}
+	ph, id := newRatesHandler(t, rs)
+	srv := httptest.NewServer(http.HandlerFunc(ph.Stream))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/products/stream?currency=USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("expected a 200 event stream, got %d %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	br := bufio.NewReader(resp.Body)
+
+	ev, _ := readSSE(t, br)
+	if ev == nil || ev.Rate != nil || !ev.Converted || priceOf(t, ev, id) != "3.41 USD" {
+		t.Fatalf("expected a first event at 3.41 USD without a rate, got %+v", ev)
+	}
+
+	// nothing has changed, so the stream is kept open with heartbeats
+	if ev, hb := readSSE(t, br); !hb || ev != nil {
+		t.Fatalf("expected a heartbeat, got %+v", ev)
+	}
+
+	waitFor(t, "the rate subscription", func() bool {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+		return len(rs.streams) > 0
+	})
+	rs.push(protos.Currencies_USD, 1.2)
+	for ev = nil; ev == nil; {
+		ev, _ = readSSE(t, br)
+	}
+	if ev.Rate == nil || ev.Rate.Destination != "USD" || ev.Rate.Rate != 1.2 || priceOf(t, ev, id) != "3.72 USD" {
+		t.Fatalf("expected an event at 3.72 USD for the new rate, got %+v", ev)
+	}
+
+	// closing the stream gives its slot back
+	resp.Body.Close()
+	waitFor(t, "the stream slot", func() bool { return len(ph.streams) == 0 })
+}
+
+func TestStreamWebSocket(t *testing.T) {
+	shortHeartbeat(t)
+	rs := &rateService{rates: map[string]float64{"USD": 1.1}}
+	ph, id := newRatesHandler(t, rs)
+	srv := httptest.NewServer(http.HandlerFunc(ph.StreamWebSocket))
+	defer srv.Close()
+
+	c, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/products/stream/ws?currency=USD", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// the pings are only seen while reading, so the messages are read
+	// on their own goroutine
+	pings := make(chan struct{}, 1)
+	c.SetPingHandler(func(string) error {
+		select {
+		case pings <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	events := make(chan *PriceEvent)
+	go func() {
+		defer close(events)
+		for {
+			ev := &PriceEvent{}
+			if err := c.ReadJSON(ev); err != nil {
+				return
+			}
+			events <- ev
+		}
+	}()
+	next := func(what string) *PriceEvent {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				t.Fatalf("stream closed waiting for %s", what)
+			}
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		return nil
+	}
+
+	ev := next("the first event")
+	if ev.Rate != nil || !ev.Converted || priceOf(t, ev, id) != "3.41 USD" {
+		t.Fatalf("expected a first event at 3.41 USD without a rate, got %+v", ev)
+	}
+
+	select {
+	case <-pings:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a ping")
+	}
+
+	waitFor(t, "the rate subscription", func() bool {
+		rs.mu.Lock()
+		defer rs.mu.Unlock()
+		return len(rs.streams) > 0
+	})
+	rs.push(protos.Currencies_USD, 1.2)
+	ev = next("the new rate")
+	if ev.Rate == nil || ev.Rate.Rate != 1.2 || priceOf(t, ev, id) != "3.72 USD" {
+		t.Fatalf("expected an event at 3.72 USD for the new rate, got %+v", ev)
+	}
+
+	c.Close()
+	waitFor(t, "the stream slot", func() bool { return len(ph.streams) == 0 })
+}
+
+func TestStreamTooMany(t *testing.T) {
+	ph, _ := newMemoryHandler(t)
+	for i := 0; i < maxStreams; i++ {
+		ph.streams <- struct{}{}
+	}
+
+	for name, h := range map[string]http.HandlerFunc{"sse": ph.Stream, "websocket": ph.StreamWebSocket} {
+		rw := httptest.NewRecorder()
+		h(rw, httptest.NewRequest(http.MethodGet, "/products/stream?currency=USD", nil))
+		if rw.Code != http.StatusServiceUnavailable || rw.Header().Get("Retry-After") != "15" {
+			t.Fatalf("%s: expected 503 with Retry-After 15, got %d %q", name, rw.Code, rw.Header().Get("Retry-After"))
+		}
+	}
+	if len(ph.streams) != maxStreams {
+		t.Fatalf("expected the rejected streams not to take a slot, got %d", len(ph.streams))
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/data"
+	"go.uber.org/zap"
+)
+
+// swagger:route GET /products/trash products listDeletedProducts
+// Return the products in the trash, most recently deleted first
+// responses:
+//	200: productsTrashResponse
+
+// ListTrash handles GET requests and returns the deleted products
+func (p *ProductsHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+
+	p.l.Info("Handle GET Products trash")
+
+	w.Header().Add("Content-Type", "application/json")
+
+	lp, err := p.db.GetDeletedProducts(r.Context())
+	if err != nil {
+		p.l.Error("unable to fetch deleted products", zap.Error(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	err = data.ToJSON(lp, w)
+	if err != nil {
+		p.l.Error("serializing products", zap.Error(err))
+	}
+}
+
+// swagger:route POST /products/{id}/restore products restoreProduct
+// Take a product out of the trash
+//
+// responses:
+//	204: noContentResponse
+//  404: errorResponse
+//  409: errorConflict
+
+// Restore handles POST requests and takes items out of the trash
+func (p *ProductsHandler) Restore(w http.ResponseWriter, r *http.Request) {
+
+	id := p.getProductID(r)
+
+	p.l.Info("restoring record", zap.Any("id:", id))
+
+	err := p.db.RestoreProduct(r.Context(), id)
+	if err == data.ErrProductNotFound {
+		p.l.Error("restoring record id is not in the trash", zap.Error(err))
+
+		w.WriteHeader(http.StatusNotFound)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	if writeSKUConflict(w, err, nil) {
+		p.l.Error("restoring record whose sku is taken", zap.Error(err))
+		return
+	}
+
+	if err != nil {
+		p.l.Error("restoring record", zap.Error(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+		data.ToJSON(&GenericError{Message: err.Error()}, w)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -29,14 +29,18 @@ func TestPlayservicesClient(t *testing.T) {
 		name := "asdf"
 		description := "fsdfa"
 		sku := "asdf-asa-aadfa"
-		price := float32(55)
-
-		payload := []*models.Product{
-			{
-				Name:        &name,
-				Description: description,
-				Price:       &price,
-				SKU:         &sku,
+		amount := "55.00"
+		currency := "EUR"
+		price := models.Money{Amount: &amount, Currency: &currency}
+
+		payload := &models.ProductPage{
+			Items: []*models.Product{
+				{
+					Name:        &name,
+					Description: description,
+					Price:       &price,
+					SKU:         &sku,
+				},
 			},
 		}
 
@@ -56,8 +60,8 @@ func TestPlayservicesClient(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	product := prod.GetPayload()[0]
-	fmt.Printf("Name: %s, Description: %s, Price: %.2f, SKU: %s\n",
-		*product.Name, product.Description, *product.Price, *product.SKU)
+	product := prod.GetPayload().Items[0]
+	fmt.Printf("Name: %s, Description: %s, Price: %s %s, SKU: %s\n",
+		*product.Name, product.Description, *product.Price.Amount, *product.Price.Currency, *product.SKU)
 	//t.Fail()
 }
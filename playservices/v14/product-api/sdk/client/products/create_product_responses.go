@@ -30,6 +30,18 @@ func (o *CreateProductReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 409:
+		result := NewCreateProductConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewCreateProductRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewCreateProductUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -117,6 +129,146 @@ func (o *CreateProductOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewCreateProductConflict creates a CreateProductConflict with default headers values
+func NewCreateProductConflict() *CreateProductConflict {
+	return &CreateProductConflict{}
+}
+
+/*
+CreateProductConflict describes a response with status code 409, with default header values.
+
+The SKU of a product is already used by another product
+*/
+type CreateProductConflict struct {
+	Payload *models.ConflictError
+}
+
+// IsSuccess returns true when this create product conflict response has a 2xx status code
+func (o *CreateProductConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create product conflict response has a 3xx status code
+func (o *CreateProductConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create product conflict response has a 4xx status code
+func (o *CreateProductConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create product conflict response has a 5xx status code
+func (o *CreateProductConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create product conflict response a status code equal to that given
+func (o *CreateProductConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create product conflict response
+func (o *CreateProductConflict) Code() int {
+	return 409
+}
+
+func (o *CreateProductConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products][%d] createProductConflict %s", 409, payload)
+}
+
+func (o *CreateProductConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products][%d] createProductConflict %s", 409, payload)
+}
+
+func (o *CreateProductConflict) GetPayload() *models.ConflictError {
+	return o.Payload
+}
+
+func (o *CreateProductConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConflictError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateProductRequestEntityTooLarge creates a CreateProductRequestEntityTooLarge with default headers values
+func NewCreateProductRequestEntityTooLarge() *CreateProductRequestEntityTooLarge {
+	return &CreateProductRequestEntityTooLarge{}
+}
+
+/*
+CreateProductRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+Generic error message returned as a string
+*/
+type CreateProductRequestEntityTooLarge struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this create product request entity too large response has a 2xx status code
+func (o *CreateProductRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create product request entity too large response has a 3xx status code
+func (o *CreateProductRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create product request entity too large response has a 4xx status code
+func (o *CreateProductRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create product request entity too large response has a 5xx status code
+func (o *CreateProductRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create product request entity too large response a status code equal to that given
+func (o *CreateProductRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the create product request entity too large response
+func (o *CreateProductRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *CreateProductRequestEntityTooLarge) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products][%d] createProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *CreateProductRequestEntityTooLarge) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products][%d] createProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *CreateProductRequestEntityTooLarge) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *CreateProductRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateProductUnprocessableEntity creates a CreateProductUnprocessableEntity with default headers values
 func NewCreateProductUnprocessableEntity() *CreateProductUnprocessableEntity {
 	return &CreateProductUnprocessableEntity{}
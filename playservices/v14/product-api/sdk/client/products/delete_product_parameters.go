@@ -62,6 +62,12 @@ DeleteProductParams contains all the parameters to send to the API endpoint
 */
 type DeleteProductParams struct {
 
+	/* XUser.
+
+	   Who is deleting the product, recorded as deletedBy
+	*/
+	User *string
+
 	/* ID.
 
 	   The id of the product for which the operation relates
@@ -123,6 +129,17 @@ func (o *DeleteProductParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithUser adds the xUser to the delete product params
+func (o *DeleteProductParams) WithUser(xUser *string) *DeleteProductParams {
+	o.SetUser(xUser)
+	return o
+}
+
+// SetUser adds the xUser to the delete product params
+func (o *DeleteProductParams) SetUser(xUser *string) {
+	o.User = xUser
+}
+
 // WithID adds the id to the delete product params
 func (o *DeleteProductParams) WithID(id int64) *DeleteProductParams {
 	o.SetID(id)
@@ -142,6 +159,14 @@ func (o *DeleteProductParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
+	if o.User != nil {
+
+		// header param X-User
+		if err := r.SetHeaderParam("X-User", *o.User); err != nil {
+			return err
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
 		return err
@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewExportProductsParams creates a new ExportProductsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewExportProductsParams() *ExportProductsParams {
+	return &ExportProductsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportProductsParamsWithTimeout creates a new ExportProductsParams object
+// with the ability to set a timeout on a request.
+func NewExportProductsParamsWithTimeout(timeout time.Duration) *ExportProductsParams {
+	return &ExportProductsParams{
+		timeout: timeout,
+	}
+}
+
+// NewExportProductsParamsWithContext creates a new ExportProductsParams object
+// with the ability to set a context for a request.
+func NewExportProductsParamsWithContext(ctx context.Context) *ExportProductsParams {
+	return &ExportProductsParams{
+		Context: ctx,
+	}
+}
+
+// NewExportProductsParamsWithHTTPClient creates a new ExportProductsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewExportProductsParamsWithHTTPClient(client *http.Client) *ExportProductsParams {
+	return &ExportProductsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ExportProductsParams contains all the parameters to send to the API endpoint
+
+	for the export products operation.
+
+	Typically these are written to a http.Request.
+*/
+type ExportProductsParams struct {
+
+	/* Format.
+
+	     File format, csv or ndjson. Imports fall back to the Content-Type
+	of the body and both default to ndjson
+	*/
+	Format *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the export products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportProductsParams) WithDefaults() *ExportProductsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the export products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ExportProductsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the export products params
+func (o *ExportProductsParams) WithTimeout(timeout time.Duration) *ExportProductsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the export products params
+func (o *ExportProductsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the export products params
+func (o *ExportProductsParams) WithContext(ctx context.Context) *ExportProductsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the export products params
+func (o *ExportProductsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the export products params
+func (o *ExportProductsParams) WithHTTPClient(client *http.Client) *ExportProductsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the export products params
+func (o *ExportProductsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the export products params
+func (o *ExportProductsParams) WithFormat(format *string) *ExportProductsParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the export products params
+func (o *ExportProductsParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
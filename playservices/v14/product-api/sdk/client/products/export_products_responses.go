@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// ExportProductsReader is a Reader for the ExportProducts structure.
+type ExportProductsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ExportProductsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewExportProductsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewExportProductsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /products/export] exportProducts", response, response.Code())
+	}
+}
+
+// NewExportProductsOK creates a ExportProductsOK with default headers values
+func NewExportProductsOK() *ExportProductsOK {
+	return &ExportProductsOK{}
+}
+
+/*
+	ExportProductsOK describes a response with status code 200, with default header values.
+
+	Every product which is not in the trash, one per line for NDJSON or one
+
+per row after a header for CSV
+*/
+type ExportProductsOK struct {
+}
+
+// IsSuccess returns true when this export products o k response has a 2xx status code
+func (o *ExportProductsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this export products o k response has a 3xx status code
+func (o *ExportProductsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export products o k response has a 4xx status code
+func (o *ExportProductsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this export products o k response has a 5xx status code
+func (o *ExportProductsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export products o k response a status code equal to that given
+func (o *ExportProductsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the export products o k response
+func (o *ExportProductsOK) Code() int {
+	return 200
+}
+
+func (o *ExportProductsOK) Error() string {
+	return fmt.Sprintf("[GET /products/export][%d] exportProductsOK", 200)
+}
+
+func (o *ExportProductsOK) String() string {
+	return fmt.Sprintf("[GET /products/export][%d] exportProductsOK", 200)
+}
+
+func (o *ExportProductsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewExportProductsBadRequest creates a ExportProductsBadRequest with default headers values
+func NewExportProductsBadRequest() *ExportProductsBadRequest {
+	return &ExportProductsBadRequest{}
+}
+
+/*
+ExportProductsBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type ExportProductsBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this export products bad request response has a 2xx status code
+func (o *ExportProductsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this export products bad request response has a 3xx status code
+func (o *ExportProductsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this export products bad request response has a 4xx status code
+func (o *ExportProductsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this export products bad request response has a 5xx status code
+func (o *ExportProductsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this export products bad request response a status code equal to that given
+func (o *ExportProductsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the export products bad request response
+func (o *ExportProductsBadRequest) Code() int {
+	return 400
+}
+
+func (o *ExportProductsBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/export][%d] exportProductsBadRequest %s", 400, payload)
+}
+
+func (o *ExportProductsBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/export][%d] exportProductsBadRequest %s", 400, payload)
+}
+
+func (o *ExportProductsBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *ExportProductsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
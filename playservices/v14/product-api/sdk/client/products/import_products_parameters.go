@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewImportProductsParams creates a new ImportProductsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewImportProductsParams() *ImportProductsParams {
+	return &ImportProductsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportProductsParamsWithTimeout creates a new ImportProductsParams object
+// with the ability to set a timeout on a request.
+func NewImportProductsParamsWithTimeout(timeout time.Duration) *ImportProductsParams {
+	return &ImportProductsParams{
+		timeout: timeout,
+	}
+}
+
+// NewImportProductsParamsWithContext creates a new ImportProductsParams object
+// with the ability to set a context for a request.
+func NewImportProductsParamsWithContext(ctx context.Context) *ImportProductsParams {
+	return &ImportProductsParams{
+		Context: ctx,
+	}
+}
+
+// NewImportProductsParamsWithHTTPClient creates a new ImportProductsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewImportProductsParamsWithHTTPClient(client *http.Client) *ImportProductsParams {
+	return &ImportProductsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ImportProductsParams contains all the parameters to send to the API endpoint
+
+	for the import products operation.
+
+	Typically these are written to a http.Request.
+*/
+type ImportProductsParams struct {
+
+	/* Body.
+
+	     Products as CSV with a header row naming the columns, or as one JSON
+	product per line. CSV needs name, price and sku columns, description
+	and currency are optional
+	*/
+	Body string
+
+	/* Format.
+
+	     File format, csv or ndjson. Imports fall back to the Content-Type
+	of the body and both default to ndjson
+	*/
+	Format *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the import products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportProductsParams) WithDefaults() *ImportProductsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the import products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ImportProductsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the import products params
+func (o *ImportProductsParams) WithTimeout(timeout time.Duration) *ImportProductsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the import products params
+func (o *ImportProductsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the import products params
+func (o *ImportProductsParams) WithContext(ctx context.Context) *ImportProductsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the import products params
+func (o *ImportProductsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the import products params
+func (o *ImportProductsParams) WithHTTPClient(client *http.Client) *ImportProductsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the import products params
+func (o *ImportProductsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the import products params
+func (o *ImportProductsParams) WithBody(body string) *ImportProductsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the import products params
+func (o *ImportProductsParams) SetBody(body string) {
+	o.Body = body
+}
+
+// WithFormat adds the format to the import products params
+func (o *ImportProductsParams) WithFormat(format *string) *ImportProductsParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the import products params
+func (o *ImportProductsParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
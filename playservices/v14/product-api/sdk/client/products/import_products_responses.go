@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// ImportProductsReader is a Reader for the ImportProducts structure.
+type ImportProductsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ImportProductsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewImportProductsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewImportProductsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewImportProductsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /products/import] importProducts", response, response.Code())
+	}
+}
+
+// NewImportProductsOK creates a ImportProductsOK with default headers values
+func NewImportProductsOK() *ImportProductsOK {
+	return &ImportProductsOK{}
+}
+
+/*
+ImportProductsOK describes a response with status code 200, with default header values.
+
+The outcome of a bulk import
+*/
+type ImportProductsOK struct {
+	Payload *models.ImportReport
+}
+
+// IsSuccess returns true when this import products o k response has a 2xx status code
+func (o *ImportProductsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this import products o k response has a 3xx status code
+func (o *ImportProductsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import products o k response has a 4xx status code
+func (o *ImportProductsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this import products o k response has a 5xx status code
+func (o *ImportProductsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this import products o k response a status code equal to that given
+func (o *ImportProductsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the import products o k response
+func (o *ImportProductsOK) Code() int {
+	return 200
+}
+
+func (o *ImportProductsOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsOK %s", 200, payload)
+}
+
+func (o *ImportProductsOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsOK %s", 200, payload)
+}
+
+func (o *ImportProductsOK) GetPayload() *models.ImportReport {
+	return o.Payload
+}
+
+func (o *ImportProductsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImportReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportProductsBadRequest creates a ImportProductsBadRequest with default headers values
+func NewImportProductsBadRequest() *ImportProductsBadRequest {
+	return &ImportProductsBadRequest{}
+}
+
+/*
+ImportProductsBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type ImportProductsBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this import products bad request response has a 2xx status code
+func (o *ImportProductsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this import products bad request response has a 3xx status code
+func (o *ImportProductsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import products bad request response has a 4xx status code
+func (o *ImportProductsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this import products bad request response has a 5xx status code
+func (o *ImportProductsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this import products bad request response a status code equal to that given
+func (o *ImportProductsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the import products bad request response
+func (o *ImportProductsBadRequest) Code() int {
+	return 400
+}
+
+func (o *ImportProductsBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsBadRequest %s", 400, payload)
+}
+
+func (o *ImportProductsBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsBadRequest %s", 400, payload)
+}
+
+func (o *ImportProductsBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *ImportProductsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewImportProductsInternalServerError creates a ImportProductsInternalServerError with default headers values
+func NewImportProductsInternalServerError() *ImportProductsInternalServerError {
+	return &ImportProductsInternalServerError{}
+}
+
+/*
+ImportProductsInternalServerError describes a response with status code 500, with default header values.
+
+Generic error message returned as a string
+*/
+type ImportProductsInternalServerError struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this import products internal server error response has a 2xx status code
+func (o *ImportProductsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this import products internal server error response has a 3xx status code
+func (o *ImportProductsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this import products internal server error response has a 4xx status code
+func (o *ImportProductsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this import products internal server error response has a 5xx status code
+func (o *ImportProductsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this import products internal server error response a status code equal to that given
+func (o *ImportProductsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the import products internal server error response
+func (o *ImportProductsInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ImportProductsInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsInternalServerError %s", 500, payload)
+}
+
+func (o *ImportProductsInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/import][%d] importProductsInternalServerError %s", 500, payload)
+}
+
+func (o *ImportProductsInternalServerError) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *ImportProductsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
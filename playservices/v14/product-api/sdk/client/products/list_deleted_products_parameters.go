@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListDeletedProductsParams creates a new ListDeletedProductsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListDeletedProductsParams() *ListDeletedProductsParams {
+	return &ListDeletedProductsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListDeletedProductsParamsWithTimeout creates a new ListDeletedProductsParams object
+// with the ability to set a timeout on a request.
+func NewListDeletedProductsParamsWithTimeout(timeout time.Duration) *ListDeletedProductsParams {
+	return &ListDeletedProductsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListDeletedProductsParamsWithContext creates a new ListDeletedProductsParams object
+// with the ability to set a context for a request.
+func NewListDeletedProductsParamsWithContext(ctx context.Context) *ListDeletedProductsParams {
+	return &ListDeletedProductsParams{
+		Context: ctx,
+	}
+}
+
+// NewListDeletedProductsParamsWithHTTPClient creates a new ListDeletedProductsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListDeletedProductsParamsWithHTTPClient(client *http.Client) *ListDeletedProductsParams {
+	return &ListDeletedProductsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListDeletedProductsParams contains all the parameters to send to the API endpoint
+
+	for the list deleted products operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListDeletedProductsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list deleted products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDeletedProductsParams) WithDefaults() *ListDeletedProductsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list deleted products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListDeletedProductsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list deleted products params
+func (o *ListDeletedProductsParams) WithTimeout(timeout time.Duration) *ListDeletedProductsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list deleted products params
+func (o *ListDeletedProductsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list deleted products params
+func (o *ListDeletedProductsParams) WithContext(ctx context.Context) *ListDeletedProductsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list deleted products params
+func (o *ListDeletedProductsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list deleted products params
+func (o *ListDeletedProductsParams) WithHTTPClient(client *http.Client) *ListDeletedProductsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list deleted products params
+func (o *ListDeletedProductsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListDeletedProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
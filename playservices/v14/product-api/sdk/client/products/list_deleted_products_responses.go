@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// ListDeletedProductsReader is a Reader for the ListDeletedProducts structure.
+type ListDeletedProductsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListDeletedProductsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListDeletedProductsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, runtime.NewAPIError("[GET /products/trash] listDeletedProducts", response, response.Code())
+	}
+}
+
+// NewListDeletedProductsOK creates a ListDeletedProductsOK with default headers values
+func NewListDeletedProductsOK() *ListDeletedProductsOK {
+	return &ListDeletedProductsOK{}
+}
+
+/*
+ListDeletedProductsOK describes a response with status code 200, with default header values.
+
+The products in the trash, most recently deleted first
+*/
+type ListDeletedProductsOK struct {
+	Payload []*models.Product
+}
+
+// IsSuccess returns true when this list deleted products o k response has a 2xx status code
+func (o *ListDeletedProductsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list deleted products o k response has a 3xx status code
+func (o *ListDeletedProductsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list deleted products o k response has a 4xx status code
+func (o *ListDeletedProductsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list deleted products o k response has a 5xx status code
+func (o *ListDeletedProductsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list deleted products o k response a status code equal to that given
+func (o *ListDeletedProductsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list deleted products o k response
+func (o *ListDeletedProductsOK) Code() int {
+	return 200
+}
+
+func (o *ListDeletedProductsOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/trash][%d] listDeletedProductsOK %s", 200, payload)
+}
+
+func (o *ListDeletedProductsOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/trash][%d] listDeletedProductsOK %s", 200, payload)
+}
+
+func (o *ListDeletedProductsOK) GetPayload() []*models.Product {
+	return o.Payload
+}
+
+func (o *ListDeletedProductsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
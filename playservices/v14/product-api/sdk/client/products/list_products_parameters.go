@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListProductsParams creates a new ListProductsParams object,
@@ -65,9 +66,63 @@ type ListProductsParams struct {
 
 	     Currency used when returning the price of the product,
 	when not specified currency is returned in GBP.
+	A currency the currency service has no rate for is rejected with a 400
 	*/
 	Currency *string
 
+	/* Currencies.
+
+	     Comma separated ISO 4217 codes, such as USD,GBP,JPY, the price in each
+	currency is returned in the prices object of every product, a currency
+	the currency service has no rate for is rejected with a 400
+	*/
+	Currencies *string
+
+	/* Cursor.
+
+	   Opaque cursor taken from the nextCursor of the previous page
+	*/
+	Cursor *string
+
+	/* Limit.
+
+	   Maximum number of products to return, defaults to 50 and is capped at 200
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* MaxPrice.
+
+	   Only return products priced at less than or equal to this decimal amount of priceCurrency
+	*/
+	MaxPrice *string
+
+	/* MinPrice.
+
+	   Only return products priced at greater than or equal to this decimal amount of priceCurrency
+	*/
+	MinPrice *string
+
+	/* PriceCurrency.
+
+	     Only return the products priced in this ISO 4217 currency, it is the
+	currency of minPrice and maxPrice and defaults to EUR when they are set
+	*/
+	PriceCurrency *string
+
+	/* Sku.
+
+	   Only return the product with this SKU
+	*/
+	SKU *string
+
+	/* Sort.
+
+	   Sort order, one of price, -price, name or -name, sorting by price groups the products by the currency they are priced in
+	*/
+	Sort *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -132,6 +187,94 @@ func (o *ListProductsParams) SetCurrency(currency *string) {
 	o.Currency = currency
 }
 
+// WithCurrencies adds the currencies to the list products params
+func (o *ListProductsParams) WithCurrencies(currencies *string) *ListProductsParams {
+	o.SetCurrencies(currencies)
+	return o
+}
+
+// SetCurrencies adds the currencies to the list products params
+func (o *ListProductsParams) SetCurrencies(currencies *string) {
+	o.Currencies = currencies
+}
+
+// WithCursor adds the cursor to the list products params
+func (o *ListProductsParams) WithCursor(cursor *string) *ListProductsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list products params
+func (o *ListProductsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithLimit adds the limit to the list products params
+func (o *ListProductsParams) WithLimit(limit *int64) *ListProductsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list products params
+func (o *ListProductsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxPrice adds the maxPrice to the list products params
+func (o *ListProductsParams) WithMaxPrice(maxPrice *string) *ListProductsParams {
+	o.SetMaxPrice(maxPrice)
+	return o
+}
+
+// SetMaxPrice adds the maxPrice to the list products params
+func (o *ListProductsParams) SetMaxPrice(maxPrice *string) {
+	o.MaxPrice = maxPrice
+}
+
+// WithMinPrice adds the minPrice to the list products params
+func (o *ListProductsParams) WithMinPrice(minPrice *string) *ListProductsParams {
+	o.SetMinPrice(minPrice)
+	return o
+}
+
+// SetMinPrice adds the minPrice to the list products params
+func (o *ListProductsParams) SetMinPrice(minPrice *string) {
+	o.MinPrice = minPrice
+}
+
+// WithPriceCurrency adds the priceCurrency to the list products params
+func (o *ListProductsParams) WithPriceCurrency(priceCurrency *string) *ListProductsParams {
+	o.SetPriceCurrency(priceCurrency)
+	return o
+}
+
+// SetPriceCurrency adds the priceCurrency to the list products params
+func (o *ListProductsParams) SetPriceCurrency(priceCurrency *string) {
+	o.PriceCurrency = priceCurrency
+}
+
+// WithSKU adds the sku to the list products params
+func (o *ListProductsParams) WithSKU(sku *string) *ListProductsParams {
+	o.SetSKU(sku)
+	return o
+}
+
+// SetSKU adds the sku to the list products params
+func (o *ListProductsParams) SetSKU(sku *string) {
+	o.SKU = sku
+}
+
+// WithSort adds the sort to the list products params
+func (o *ListProductsParams) WithSort(sort *string) *ListProductsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list products params
+func (o *ListProductsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -157,6 +300,142 @@ func (o *ListProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.Currencies != nil {
+
+		// query param currencies
+		var qrCurrencies string
+
+		if o.Currencies != nil {
+			qrCurrencies = *o.Currencies
+		}
+		qCurrencies := qrCurrencies
+		if qCurrencies != "" {
+
+			if err := r.SetQueryParam("currencies", qCurrencies); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxPrice != nil {
+
+		// query param maxPrice
+		var qrMaxPrice string
+
+		if o.MaxPrice != nil {
+			qrMaxPrice = *o.MaxPrice
+		}
+		qMaxPrice := qrMaxPrice
+		if qMaxPrice != "" {
+
+			if err := r.SetQueryParam("maxPrice", qMaxPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinPrice != nil {
+
+		// query param minPrice
+		var qrMinPrice string
+
+		if o.MinPrice != nil {
+			qrMinPrice = *o.MinPrice
+		}
+		qMinPrice := qrMinPrice
+		if qMinPrice != "" {
+
+			if err := r.SetQueryParam("minPrice", qMinPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PriceCurrency != nil {
+
+		// query param priceCurrency
+		var qrPriceCurrency string
+
+		if o.PriceCurrency != nil {
+			qrPriceCurrency = *o.PriceCurrency
+		}
+		qPriceCurrency := qrPriceCurrency
+		if qPriceCurrency != "" {
+
+			if err := r.SetQueryParam("priceCurrency", qPriceCurrency); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SKU != nil {
+
+		// query param sku
+		var qrSku string
+
+		if o.SKU != nil {
+			qrSku = *o.SKU
+		}
+		qSku := qrSku
+		if qSku != "" {
+
+			if err := r.SetQueryParam("sku", qSku); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
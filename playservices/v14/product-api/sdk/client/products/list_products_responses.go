@@ -30,6 +30,12 @@ func (o *ListProductsReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListProductsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("[GET /products] listProducts", response, response.Code())
 	}
@@ -43,10 +49,15 @@ func NewListProductsOK() *ListProductsOK {
 /*
 ListProductsOK describes a response with status code 200, with default header values.
 
-A list of products
+A page of products
 */
 type ListProductsOK struct {
-	Payload []*models.Product
+
+	/* Set to unavailable when the prices could not be converted and are in their stored currency
+	 */
+	XCurrencyConversion string
+
+	Payload *models.ProductPage
 }
 
 // IsSuccess returns true when this list products o k response has a 2xx status code
@@ -89,14 +100,93 @@ func (o *ListProductsOK) String() string {
 	return fmt.Sprintf("[GET /products][%d] listProductsOK %s", 200, payload)
 }
 
-func (o *ListProductsOK) GetPayload() []*models.Product {
+func (o *ListProductsOK) GetPayload() *models.ProductPage {
 	return o.Payload
 }
 
 func (o *ListProductsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header X-Currency-Conversion
+	hdrXCurrencyConversion := response.GetHeader("X-Currency-Conversion")
+
+	if hdrXCurrencyConversion != "" {
+		o.XCurrencyConversion = hdrXCurrencyConversion
+	}
+
+	o.Payload = new(models.ProductPage)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListProductsBadRequest creates a ListProductsBadRequest with default headers values
+func NewListProductsBadRequest() *ListProductsBadRequest {
+	return &ListProductsBadRequest{}
+}
+
+/*
+ListProductsBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type ListProductsBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this list products bad request response has a 2xx status code
+func (o *ListProductsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list products bad request response has a 3xx status code
+func (o *ListProductsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list products bad request response has a 4xx status code
+func (o *ListProductsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list products bad request response has a 5xx status code
+func (o *ListProductsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list products bad request response a status code equal to that given
+func (o *ListProductsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list products bad request response
+func (o *ListProductsBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListProductsBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products][%d] listProductsBadRequest %s", 400, payload)
+}
+
+func (o *ListProductsBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products][%d] listProductsBadRequest %s", 400, payload)
+}
+
+func (o *ListProductsBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *ListProductsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
 	// response payload
-	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
 		return err
 	}
 
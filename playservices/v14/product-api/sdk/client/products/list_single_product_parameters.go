@@ -66,9 +66,18 @@ type ListSingleProductParams struct {
 
 	     Currency used when returning the price of the product,
 	when not specified currency is returned in GBP.
+	A currency the currency service has no rate for is rejected with a 400
 	*/
 	Currency *string
 
+	/* Currencies.
+
+	     Comma separated ISO 4217 codes, such as USD,GBP,JPY, the price in each
+	currency is returned in the prices object of every product, a currency
+	the currency service has no rate for is rejected with a 400
+	*/
+	Currencies *string
+
 	/* ID.
 
 	   The id of the product for which the operation relates
@@ -141,6 +150,17 @@ func (o *ListSingleProductParams) SetCurrency(currency *string) {
 	o.Currency = currency
 }
 
+// WithCurrencies adds the currencies to the list single product params
+func (o *ListSingleProductParams) WithCurrencies(currencies *string) *ListSingleProductParams {
+	o.SetCurrencies(currencies)
+	return o
+}
+
+// SetCurrencies adds the currencies to the list single product params
+func (o *ListSingleProductParams) SetCurrencies(currencies *string) {
+	o.Currencies = currencies
+}
+
 // WithID adds the id to the list single product params
 func (o *ListSingleProductParams) WithID(id int64) *ListSingleProductParams {
 	o.SetID(id)
@@ -177,6 +197,23 @@ func (o *ListSingleProductParams) WriteToRequest(r runtime.ClientRequest, reg st
 		}
 	}
 
+	if o.Currencies != nil {
+
+		// query param currencies
+		var qrCurrencies string
+
+		if o.Currencies != nil {
+			qrCurrencies = *o.Currencies
+		}
+		qCurrencies := qrCurrencies
+		if qCurrencies != "" {
+
+			if err := r.SetQueryParam("currencies", qCurrencies); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
 		return err
@@ -30,6 +30,18 @@ func (o *ListSingleProductReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return result, nil
+	case 304:
+		result := NewListSingleProductNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewListSingleProductBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewListSingleProductNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -49,9 +61,19 @@ func NewListSingleProductOK() *ListSingleProductOK {
 /*
 ListSingleProductOK describes a response with status code 200, with default header values.
 
-Data structure representing a single product
+Data structure representing a single product with its version
 */
 type ListSingleProductOK struct {
+
+	/* The product version, followed by a hash of the converted prices when a
+	currency was asked for, send it back in If-Match when updating the product
+	*/
+	ETag string
+
+	/* Set to unavailable when the prices could not be converted and are in their stored currency
+	 */
+	XCurrencyConversion string
+
 	Payload *models.Product
 }
 
@@ -101,6 +123,20 @@ func (o *ListSingleProductOK) GetPayload() *models.Product {
 
 func (o *ListSingleProductOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	// hydrates response header X-Currency-Conversion
+	hdrXCurrencyConversion := response.GetHeader("X-Currency-Conversion")
+
+	if hdrXCurrencyConversion != "" {
+		o.XCurrencyConversion = hdrXCurrencyConversion
+	}
+
 	o.Payload = new(models.Product)
 
 	// response payload
@@ -111,6 +147,132 @@ func (o *ListSingleProductOK) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewListSingleProductNotModified creates a ListSingleProductNotModified with default headers values
+func NewListSingleProductNotModified() *ListSingleProductNotModified {
+	return &ListSingleProductNotModified{}
+}
+
+/*
+ListSingleProductNotModified describes a response with status code 304, with default header values.
+
+The product has not changed since the version in If-None-Match
+*/
+type ListSingleProductNotModified struct {
+}
+
+// IsSuccess returns true when this list single product not modified response has a 2xx status code
+func (o *ListSingleProductNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list single product not modified response has a 3xx status code
+func (o *ListSingleProductNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this list single product not modified response has a 4xx status code
+func (o *ListSingleProductNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list single product not modified response has a 5xx status code
+func (o *ListSingleProductNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list single product not modified response a status code equal to that given
+func (o *ListSingleProductNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the list single product not modified response
+func (o *ListSingleProductNotModified) Code() int {
+	return 304
+}
+
+func (o *ListSingleProductNotModified) Error() string {
+	return fmt.Sprintf("[GET /products/{id}][%d] listSingleProductNotModified", 304)
+}
+
+func (o *ListSingleProductNotModified) String() string {
+	return fmt.Sprintf("[GET /products/{id}][%d] listSingleProductNotModified", 304)
+}
+
+func (o *ListSingleProductNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListSingleProductBadRequest creates a ListSingleProductBadRequest with default headers values
+func NewListSingleProductBadRequest() *ListSingleProductBadRequest {
+	return &ListSingleProductBadRequest{}
+}
+
+/*
+ListSingleProductBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type ListSingleProductBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this list single product bad request response has a 2xx status code
+func (o *ListSingleProductBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list single product bad request response has a 3xx status code
+func (o *ListSingleProductBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list single product bad request response has a 4xx status code
+func (o *ListSingleProductBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list single product bad request response has a 5xx status code
+func (o *ListSingleProductBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list single product bad request response a status code equal to that given
+func (o *ListSingleProductBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list single product bad request response
+func (o *ListSingleProductBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListSingleProductBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/{id}][%d] listSingleProductBadRequest %s", 400, payload)
+}
+
+func (o *ListSingleProductBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/{id}][%d] listSingleProductBadRequest %s", 400, payload)
+}
+
+func (o *ListSingleProductBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *ListSingleProductBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListSingleProductNotFound creates a ListSingleProductNotFound with default headers values
 func NewListSingleProductNotFound() *ListSingleProductNotFound {
 	return &ListSingleProductNotFound{}
@@ -0,0 +1,203 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewPatchProductParams creates a new PatchProductParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPatchProductParams() *PatchProductParams {
+	return &PatchProductParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPatchProductParamsWithTimeout creates a new PatchProductParams object
+// with the ability to set a timeout on a request.
+func NewPatchProductParamsWithTimeout(timeout time.Duration) *PatchProductParams {
+	return &PatchProductParams{
+		timeout: timeout,
+	}
+}
+
+// NewPatchProductParamsWithContext creates a new PatchProductParams object
+// with the ability to set a context for a request.
+func NewPatchProductParamsWithContext(ctx context.Context) *PatchProductParams {
+	return &PatchProductParams{
+		Context: ctx,
+	}
+}
+
+// NewPatchProductParamsWithHTTPClient creates a new PatchProductParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPatchProductParamsWithHTTPClient(client *http.Client) *PatchProductParams {
+	return &PatchProductParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PatchProductParams contains all the parameters to send to the API endpoint
+
+	for the patch product operation.
+
+	Typically these are written to a http.Request.
+*/
+type PatchProductParams struct {
+
+	/* Body.
+
+	     A JSON Merge Patch object with the fields to change, null removes
+	the description, or a JSON Patch array of operations
+	*/
+	Body interface{}
+
+	/* IfMatch.
+
+	     ETag returned by GET /products/{id}, when sent the patch is rejected
+	with 412 if the product has changed since
+	*/
+	IfMatch *string
+
+	/* ID.
+
+	   The id of the product for which the operation relates
+
+	   Format: int64
+	*/
+	ID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the patch product params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchProductParams) WithDefaults() *PatchProductParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the patch product params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchProductParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the patch product params
+func (o *PatchProductParams) WithTimeout(timeout time.Duration) *PatchProductParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the patch product params
+func (o *PatchProductParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the patch product params
+func (o *PatchProductParams) WithContext(ctx context.Context) *PatchProductParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the patch product params
+func (o *PatchProductParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the patch product params
+func (o *PatchProductParams) WithHTTPClient(client *http.Client) *PatchProductParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the patch product params
+func (o *PatchProductParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the patch product params
+func (o *PatchProductParams) WithBody(body interface{}) *PatchProductParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the patch product params
+func (o *PatchProductParams) SetBody(body interface{}) {
+	o.Body = body
+}
+
+// WithIfMatch adds the ifMatch to the patch product params
+func (o *PatchProductParams) WithIfMatch(ifMatch *string) *PatchProductParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the patch product params
+func (o *PatchProductParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
+// WithID adds the id to the patch product params
+func (o *PatchProductParams) WithID(id int64) *PatchProductParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the patch product params
+func (o *PatchProductParams) SetID(id int64) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PatchProductParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
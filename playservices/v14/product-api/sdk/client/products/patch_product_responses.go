@@ -0,0 +1,635 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// PatchProductReader is a Reader for the PatchProduct structure.
+type PatchProductReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PatchProductReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewPatchProductNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPatchProductBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPatchProductNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPatchProductConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewPatchProductPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewPatchProductRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 415:
+		result := NewPatchProductUnsupportedMediaType()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewPatchProductUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /products/{id}] patchProduct", response, response.Code())
+	}
+}
+
+// NewPatchProductNoContent creates a PatchProductNoContent with default headers values
+func NewPatchProductNoContent() *PatchProductNoContent {
+	return &PatchProductNoContent{}
+}
+
+/*
+PatchProductNoContent describes a response with status code 204, with default header values.
+
+The patch was applied
+*/
+type PatchProductNoContent struct {
+
+	/* The new product version
+	 */
+	ETag string
+}
+
+// IsSuccess returns true when this patch product no content response has a 2xx status code
+func (o *PatchProductNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this patch product no content response has a 3xx status code
+func (o *PatchProductNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product no content response has a 4xx status code
+func (o *PatchProductNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this patch product no content response has a 5xx status code
+func (o *PatchProductNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product no content response a status code equal to that given
+func (o *PatchProductNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the patch product no content response
+func (o *PatchProductNoContent) Code() int {
+	return 204
+}
+
+func (o *PatchProductNoContent) Error() string {
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductNoContent", 204)
+}
+
+func (o *PatchProductNoContent) String() string {
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductNoContent", 204)
+}
+
+func (o *PatchProductNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewPatchProductBadRequest creates a PatchProductBadRequest with default headers values
+func NewPatchProductBadRequest() *PatchProductBadRequest {
+	return &PatchProductBadRequest{}
+}
+
+/*
+PatchProductBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type PatchProductBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this patch product bad request response has a 2xx status code
+func (o *PatchProductBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product bad request response has a 3xx status code
+func (o *PatchProductBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product bad request response has a 4xx status code
+func (o *PatchProductBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product bad request response has a 5xx status code
+func (o *PatchProductBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product bad request response a status code equal to that given
+func (o *PatchProductBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the patch product bad request response
+func (o *PatchProductBadRequest) Code() int {
+	return 400
+}
+
+func (o *PatchProductBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductBadRequest %s", 400, payload)
+}
+
+func (o *PatchProductBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductBadRequest %s", 400, payload)
+}
+
+func (o *PatchProductBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *PatchProductBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductNotFound creates a PatchProductNotFound with default headers values
+func NewPatchProductNotFound() *PatchProductNotFound {
+	return &PatchProductNotFound{}
+}
+
+/*
+PatchProductNotFound describes a response with status code 404, with default header values.
+
+Generic error message returned as a string
+*/
+type PatchProductNotFound struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this patch product not found response has a 2xx status code
+func (o *PatchProductNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product not found response has a 3xx status code
+func (o *PatchProductNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product not found response has a 4xx status code
+func (o *PatchProductNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product not found response has a 5xx status code
+func (o *PatchProductNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product not found response a status code equal to that given
+func (o *PatchProductNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the patch product not found response
+func (o *PatchProductNotFound) Code() int {
+	return 404
+}
+
+func (o *PatchProductNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductNotFound %s", 404, payload)
+}
+
+func (o *PatchProductNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductNotFound %s", 404, payload)
+}
+
+func (o *PatchProductNotFound) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *PatchProductNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductConflict creates a PatchProductConflict with default headers values
+func NewPatchProductConflict() *PatchProductConflict {
+	return &PatchProductConflict{}
+}
+
+/*
+PatchProductConflict describes a response with status code 409, with default header values.
+
+The SKU of a product is already used by another product
+*/
+type PatchProductConflict struct {
+	Payload *models.ConflictError
+}
+
+// IsSuccess returns true when this patch product conflict response has a 2xx status code
+func (o *PatchProductConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product conflict response has a 3xx status code
+func (o *PatchProductConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product conflict response has a 4xx status code
+func (o *PatchProductConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product conflict response has a 5xx status code
+func (o *PatchProductConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product conflict response a status code equal to that given
+func (o *PatchProductConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the patch product conflict response
+func (o *PatchProductConflict) Code() int {
+	return 409
+}
+
+func (o *PatchProductConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductConflict %s", 409, payload)
+}
+
+func (o *PatchProductConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductConflict %s", 409, payload)
+}
+
+func (o *PatchProductConflict) GetPayload() *models.ConflictError {
+	return o.Payload
+}
+
+func (o *PatchProductConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConflictError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductPreconditionFailed creates a PatchProductPreconditionFailed with default headers values
+func NewPatchProductPreconditionFailed() *PatchProductPreconditionFailed {
+	return &PatchProductPreconditionFailed{}
+}
+
+/*
+PatchProductPreconditionFailed describes a response with status code 412, with default header values.
+
+Generic error message returned as a string
+*/
+type PatchProductPreconditionFailed struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this patch product precondition failed response has a 2xx status code
+func (o *PatchProductPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product precondition failed response has a 3xx status code
+func (o *PatchProductPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product precondition failed response has a 4xx status code
+func (o *PatchProductPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product precondition failed response has a 5xx status code
+func (o *PatchProductPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product precondition failed response a status code equal to that given
+func (o *PatchProductPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the patch product precondition failed response
+func (o *PatchProductPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *PatchProductPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductPreconditionFailed %s", 412, payload)
+}
+
+func (o *PatchProductPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductPreconditionFailed %s", 412, payload)
+}
+
+func (o *PatchProductPreconditionFailed) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *PatchProductPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductRequestEntityTooLarge creates a PatchProductRequestEntityTooLarge with default headers values
+func NewPatchProductRequestEntityTooLarge() *PatchProductRequestEntityTooLarge {
+	return &PatchProductRequestEntityTooLarge{}
+}
+
+/*
+PatchProductRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+Generic error message returned as a string
+*/
+type PatchProductRequestEntityTooLarge struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this patch product request entity too large response has a 2xx status code
+func (o *PatchProductRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product request entity too large response has a 3xx status code
+func (o *PatchProductRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product request entity too large response has a 4xx status code
+func (o *PatchProductRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product request entity too large response has a 5xx status code
+func (o *PatchProductRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product request entity too large response a status code equal to that given
+func (o *PatchProductRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the patch product request entity too large response
+func (o *PatchProductRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *PatchProductRequestEntityTooLarge) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *PatchProductRequestEntityTooLarge) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *PatchProductRequestEntityTooLarge) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *PatchProductRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductUnsupportedMediaType creates a PatchProductUnsupportedMediaType with default headers values
+func NewPatchProductUnsupportedMediaType() *PatchProductUnsupportedMediaType {
+	return &PatchProductUnsupportedMediaType{}
+}
+
+/*
+PatchProductUnsupportedMediaType describes a response with status code 415, with default header values.
+
+Generic error message returned as a string
+*/
+type PatchProductUnsupportedMediaType struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this patch product unsupported media type response has a 2xx status code
+func (o *PatchProductUnsupportedMediaType) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product unsupported media type response has a 3xx status code
+func (o *PatchProductUnsupportedMediaType) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product unsupported media type response has a 4xx status code
+func (o *PatchProductUnsupportedMediaType) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product unsupported media type response has a 5xx status code
+func (o *PatchProductUnsupportedMediaType) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product unsupported media type response a status code equal to that given
+func (o *PatchProductUnsupportedMediaType) IsCode(code int) bool {
+	return code == 415
+}
+
+// Code gets the status code for the patch product unsupported media type response
+func (o *PatchProductUnsupportedMediaType) Code() int {
+	return 415
+}
+
+func (o *PatchProductUnsupportedMediaType) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *PatchProductUnsupportedMediaType) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *PatchProductUnsupportedMediaType) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *PatchProductUnsupportedMediaType) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchProductUnprocessableEntity creates a PatchProductUnprocessableEntity with default headers values
+func NewPatchProductUnprocessableEntity() *PatchProductUnprocessableEntity {
+	return &PatchProductUnprocessableEntity{}
+}
+
+/*
+PatchProductUnprocessableEntity describes a response with status code 422, with default header values.
+
+Validation errors defined as an array of strings
+*/
+type PatchProductUnprocessableEntity struct {
+	Payload *models.ValidationError
+}
+
+// IsSuccess returns true when this patch product unprocessable entity response has a 2xx status code
+func (o *PatchProductUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch product unprocessable entity response has a 3xx status code
+func (o *PatchProductUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch product unprocessable entity response has a 4xx status code
+func (o *PatchProductUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch product unprocessable entity response has a 5xx status code
+func (o *PatchProductUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch product unprocessable entity response a status code equal to that given
+func (o *PatchProductUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the patch product unprocessable entity response
+func (o *PatchProductUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *PatchProductUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PatchProductUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /products/{id}][%d] patchProductUnprocessableEntity %s", 422, payload)
+}
+
+func (o *PatchProductUnprocessableEntity) GetPayload() *models.ValidationError {
+	return o.Payload
+}
+
+func (o *PatchProductUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ValidationError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -54,16 +54,81 @@ type Client struct {
 // ClientOption may be used to customize the behavior of Client methods.
 type ClientOption func(*runtime.ClientOperation)
 
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithContentType allows the client to force the Content-Type header
+// to negotiate a specific Consumer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithContentType(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ConsumesMediaTypes = []string{mime}
+	}
+}
+
+// WithContentTypeApplicationJSON sets the Content-Type header to "application/json".
+func WithContentTypeApplicationJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/json"}
+}
+
+// WithContentTypeApplicationJSONPatchJSON sets the Content-Type header to "application/json-patch+json".
+func WithContentTypeApplicationJSONPatchJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/json-patch+json"}
+}
+
+// WithContentTypeApplicationMergePatchJSON sets the Content-Type header to "application/merge-patch+json".
+func WithContentTypeApplicationMergePatchJSON(r *runtime.ClientOperation) {
+	r.ConsumesMediaTypes = []string{"application/merge-patch+json"}
+}
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptTextEventStream sets the Accept header to "text/event-stream".
+func WithAcceptTextEventStream(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"text/event-stream"}
+}
+
 // ClientService is the interface for Client methods
 type ClientService interface {
 	CreateProduct(params *CreateProductParams, opts ...ClientOption) (*CreateProductOK, error)
 
 	DeleteProduct(params *DeleteProductParams, opts ...ClientOption) (*DeleteProductCreated, error)
 
+	ExportProducts(params *ExportProductsParams, opts ...ClientOption) (*ExportProductsOK, error)
+
+	ImportProducts(params *ImportProductsParams, opts ...ClientOption) (*ImportProductsOK, error)
+
+	ListDeletedProducts(params *ListDeletedProductsParams, opts ...ClientOption) (*ListDeletedProductsOK, error)
+
 	ListProducts(params *ListProductsParams, opts ...ClientOption) (*ListProductsOK, error)
 
 	ListSingleProduct(params *ListSingleProductParams, opts ...ClientOption) (*ListSingleProductOK, error)
 
+	PatchProduct(params *PatchProductParams, opts ...ClientOption) (*PatchProductNoContent, error)
+
+	RestoreProduct(params *RestoreProductParams, opts ...ClientOption) (*RestoreProductNoContent, error)
+
+	SearchProducts(params *SearchProductsParams, opts ...ClientOption) (*SearchProductsOK, error)
+
+	StreamPrices(params *StreamPricesParams, opts ...ClientOption) (*StreamPricesOK, error)
+
+	StreamPricesWebSocket(params *StreamPricesWebSocketParams, opts ...ClientOption) error
+
 	UpdateProduct(params *UpdateProductParams, opts ...ClientOption) (*UpdateProductCreated, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -108,7 +173,7 @@ func (a *Client) CreateProduct(params *CreateProductParams, opts ...ClientOption
 }
 
 /*
-DeleteProduct Update a products details
+DeleteProduct Move a product to the trash
 */
 func (a *Client) DeleteProduct(params *DeleteProductParams, opts ...ClientOption) (*DeleteProductCreated, error) {
 	// TODO: Validate the params before sending
@@ -146,7 +211,123 @@ func (a *Client) DeleteProduct(params *DeleteProductParams, opts ...ClientOption
 }
 
 /*
-ListProducts Return a list of products from the database
+ExportProducts Stream every product which is not in the trash as CSV or NDJSON
+*/
+func (a *Client) ExportProducts(params *ExportProductsParams, opts ...ClientOption) (*ExportProductsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportProductsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "exportProducts",
+		Method:             "GET",
+		PathPattern:        "/products/export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ExportProductsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportProductsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for exportProducts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	ImportProducts Import products from a CSV or NDJSON body, every row is validated on its
+
+own and rows which fail are reported instead of aborting the import
+*/
+func (a *Client) ImportProducts(params *ImportProductsParams, opts ...ClientOption) (*ImportProductsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportProductsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "importProducts",
+		Method:             "POST",
+		PathPattern:        "/products/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ImportProductsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportProductsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for importProducts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListDeletedProducts Return the products in the trash, most recently deleted first
+*/
+func (a *Client) ListDeletedProducts(params *ListDeletedProductsParams, opts ...ClientOption) (*ListDeletedProductsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListDeletedProductsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listDeletedProducts",
+		Method:             "GET",
+		PathPattern:        "/products/trash",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListDeletedProductsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListDeletedProductsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listDeletedProducts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListProducts Return a page of products from the database
 */
 func (a *Client) ListProducts(params *ListProductsParams, opts ...ClientOption) (*ListProductsOK, error) {
 	// TODO: Validate the params before sending
@@ -221,6 +402,197 @@ func (a *Client) ListSingleProduct(params *ListSingleProductParams, opts ...Clie
 	panic(msg)
 }
 
+/*
+	PatchProduct Change some of the fields of a product with a JSON Merge Patch (RFC 7396)
+
+or a JSON Patch (RFC 6902), chosen by the Content-Type of the request
+*/
+func (a *Client) PatchProduct(params *PatchProductParams, opts ...ClientOption) (*PatchProductNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchProductParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "patchProduct",
+		Method:             "PATCH",
+		PathPattern:        "/products/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/merge-patch+json", "application/json-patch+json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchProductReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchProductNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for patchProduct: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RestoreProduct Take a product out of the trash
+*/
+func (a *Client) RestoreProduct(params *RestoreProductParams, opts ...ClientOption) (*RestoreProductNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreProductParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "restoreProduct",
+		Method:             "POST",
+		PathPattern:        "/products/{id}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestoreProductReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreProductNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for restoreProduct: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+SearchProducts Search the products by name and description, best match first
+*/
+func (a *Client) SearchProducts(params *SearchProductsParams, opts ...ClientOption) (*SearchProductsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchProductsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "searchProducts",
+		Method:             "GET",
+		PathPattern:        "/products/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchProductsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SearchProductsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for searchProducts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+	StreamPrices streams the products with their prices in a currency as server sent events
+
+	A prices event is sent straight away and again every time a rate into the
+
+currency changes, a comment is sent as heartbeat when nothing changes
+*/
+func (a *Client) StreamPrices(params *StreamPricesParams, opts ...ClientOption) (*StreamPricesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamPricesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "streamPrices",
+		Method:             "GET",
+		PathPattern:        "/products/stream",
+		ProducesMediaTypes: []string{"text/event-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StreamPricesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StreamPricesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for streamPrices: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+StreamPricesWebSocket streams the products with their prices in a currency over a web socket
+
+Every message is a JSON price event, heartbeats are ping frames
+*/
+func (a *Client) StreamPricesWebSocket(params *StreamPricesWebSocketParams, opts ...ClientOption) error {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStreamPricesWebSocketParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "streamPricesWebSocket",
+		Method:             "GET",
+		PathPattern:        "/products/stream/ws",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StreamPricesWebSocketReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	_, err := a.transport.Submit(op)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 /*
 UpdateProduct Update a products details
 */
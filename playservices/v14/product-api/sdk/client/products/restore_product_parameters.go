@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRestoreProductParams creates a new RestoreProductParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRestoreProductParams() *RestoreProductParams {
+	return &RestoreProductParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRestoreProductParamsWithTimeout creates a new RestoreProductParams object
+// with the ability to set a timeout on a request.
+func NewRestoreProductParamsWithTimeout(timeout time.Duration) *RestoreProductParams {
+	return &RestoreProductParams{
+		timeout: timeout,
+	}
+}
+
+// NewRestoreProductParamsWithContext creates a new RestoreProductParams object
+// with the ability to set a context for a request.
+func NewRestoreProductParamsWithContext(ctx context.Context) *RestoreProductParams {
+	return &RestoreProductParams{
+		Context: ctx,
+	}
+}
+
+// NewRestoreProductParamsWithHTTPClient creates a new RestoreProductParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRestoreProductParamsWithHTTPClient(client *http.Client) *RestoreProductParams {
+	return &RestoreProductParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RestoreProductParams contains all the parameters to send to the API endpoint
+
+	for the restore product operation.
+
+	Typically these are written to a http.Request.
+*/
+type RestoreProductParams struct {
+
+	/* ID.
+
+	   The id of the product for which the operation relates
+
+	   Format: int64
+	*/
+	ID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the restore product params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreProductParams) WithDefaults() *RestoreProductParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the restore product params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreProductParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the restore product params
+func (o *RestoreProductParams) WithTimeout(timeout time.Duration) *RestoreProductParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the restore product params
+func (o *RestoreProductParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the restore product params
+func (o *RestoreProductParams) WithContext(ctx context.Context) *RestoreProductParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the restore product params
+func (o *RestoreProductParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the restore product params
+func (o *RestoreProductParams) WithHTTPClient(client *http.Client) *RestoreProductParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the restore product params
+func (o *RestoreProductParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the restore product params
+func (o *RestoreProductParams) WithID(id int64) *RestoreProductParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the restore product params
+func (o *RestoreProductParams) SetID(id int64) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RestoreProductParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
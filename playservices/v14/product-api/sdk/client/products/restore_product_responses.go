@@ -0,0 +1,244 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// RestoreProductReader is a Reader for the RestoreProduct structure.
+type RestoreProductReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RestoreProductReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRestoreProductNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewRestoreProductNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewRestoreProductConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /products/{id}/restore] restoreProduct", response, response.Code())
+	}
+}
+
+// NewRestoreProductNoContent creates a RestoreProductNoContent with default headers values
+func NewRestoreProductNoContent() *RestoreProductNoContent {
+	return &RestoreProductNoContent{}
+}
+
+/*
+RestoreProductNoContent describes a response with status code 204, with default header values.
+
+No content is returned by this API endpoint
+*/
+type RestoreProductNoContent struct {
+}
+
+// IsSuccess returns true when this restore product no content response has a 2xx status code
+func (o *RestoreProductNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this restore product no content response has a 3xx status code
+func (o *RestoreProductNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore product no content response has a 4xx status code
+func (o *RestoreProductNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this restore product no content response has a 5xx status code
+func (o *RestoreProductNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore product no content response a status code equal to that given
+func (o *RestoreProductNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the restore product no content response
+func (o *RestoreProductNoContent) Code() int {
+	return 204
+}
+
+func (o *RestoreProductNoContent) Error() string {
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductNoContent", 204)
+}
+
+func (o *RestoreProductNoContent) String() string {
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductNoContent", 204)
+}
+
+func (o *RestoreProductNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRestoreProductNotFound creates a RestoreProductNotFound with default headers values
+func NewRestoreProductNotFound() *RestoreProductNotFound {
+	return &RestoreProductNotFound{}
+}
+
+/*
+RestoreProductNotFound describes a response with status code 404, with default header values.
+
+Generic error message returned as a string
+*/
+type RestoreProductNotFound struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this restore product not found response has a 2xx status code
+func (o *RestoreProductNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore product not found response has a 3xx status code
+func (o *RestoreProductNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore product not found response has a 4xx status code
+func (o *RestoreProductNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore product not found response has a 5xx status code
+func (o *RestoreProductNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore product not found response a status code equal to that given
+func (o *RestoreProductNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the restore product not found response
+func (o *RestoreProductNotFound) Code() int {
+	return 404
+}
+
+func (o *RestoreProductNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductNotFound %s", 404, payload)
+}
+
+func (o *RestoreProductNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductNotFound %s", 404, payload)
+}
+
+func (o *RestoreProductNotFound) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *RestoreProductNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreProductConflict creates a RestoreProductConflict with default headers values
+func NewRestoreProductConflict() *RestoreProductConflict {
+	return &RestoreProductConflict{}
+}
+
+/*
+RestoreProductConflict describes a response with status code 409, with default header values.
+
+The SKU of a product is already used by another product
+*/
+type RestoreProductConflict struct {
+	Payload *models.ConflictError
+}
+
+// IsSuccess returns true when this restore product conflict response has a 2xx status code
+func (o *RestoreProductConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore product conflict response has a 3xx status code
+func (o *RestoreProductConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore product conflict response has a 4xx status code
+func (o *RestoreProductConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore product conflict response has a 5xx status code
+func (o *RestoreProductConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore product conflict response a status code equal to that given
+func (o *RestoreProductConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the restore product conflict response
+func (o *RestoreProductConflict) Code() int {
+	return 409
+}
+
+func (o *RestoreProductConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductConflict %s", 409, payload)
+}
+
+func (o *RestoreProductConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /products/{id}/restore][%d] restoreProductConflict %s", 409, payload)
+}
+
+func (o *RestoreProductConflict) GetPayload() *models.ConflictError {
+	return o.Payload
+}
+
+func (o *RestoreProductConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConflictError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
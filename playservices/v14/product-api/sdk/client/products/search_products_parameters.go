@@ -0,0 +1,230 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewSearchProductsParams creates a new SearchProductsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSearchProductsParams() *SearchProductsParams {
+	return &SearchProductsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSearchProductsParamsWithTimeout creates a new SearchProductsParams object
+// with the ability to set a timeout on a request.
+func NewSearchProductsParamsWithTimeout(timeout time.Duration) *SearchProductsParams {
+	return &SearchProductsParams{
+		timeout: timeout,
+	}
+}
+
+// NewSearchProductsParamsWithContext creates a new SearchProductsParams object
+// with the ability to set a context for a request.
+func NewSearchProductsParamsWithContext(ctx context.Context) *SearchProductsParams {
+	return &SearchProductsParams{
+		Context: ctx,
+	}
+}
+
+// NewSearchProductsParamsWithHTTPClient creates a new SearchProductsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSearchProductsParamsWithHTTPClient(client *http.Client) *SearchProductsParams {
+	return &SearchProductsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SearchProductsParams contains all the parameters to send to the API endpoint
+
+	for the search products operation.
+
+	Typically these are written to a http.Request.
+*/
+type SearchProductsParams struct {
+
+	/* Currency.
+
+	     Currency used when returning the price of the product,
+	when not specified currency is returned in GBP.
+	A currency the currency service has no rate for is rejected with a 400
+	*/
+	Currency *string
+
+	/* Limit.
+
+	   Maximum number of products to return, defaults to 50 and is capped at 200
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* Q.
+
+	     Words to look for in the product name and description,
+	the last word also matches as a prefix
+	*/
+	Q string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the search products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchProductsParams) WithDefaults() *SearchProductsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the search products params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SearchProductsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the search products params
+func (o *SearchProductsParams) WithTimeout(timeout time.Duration) *SearchProductsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the search products params
+func (o *SearchProductsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the search products params
+func (o *SearchProductsParams) WithContext(ctx context.Context) *SearchProductsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the search products params
+func (o *SearchProductsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the search products params
+func (o *SearchProductsParams) WithHTTPClient(client *http.Client) *SearchProductsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the search products params
+func (o *SearchProductsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the search products params
+func (o *SearchProductsParams) WithCurrency(currency *string) *SearchProductsParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the search products params
+func (o *SearchProductsParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithLimit adds the limit to the search products params
+func (o *SearchProductsParams) WithLimit(limit *int64) *SearchProductsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the search products params
+func (o *SearchProductsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithQ adds the q to the search products params
+func (o *SearchProductsParams) WithQ(q string) *SearchProductsParams {
+	o.SetQ(q)
+	return o
+}
+
+// SetQ adds the q to the search products params
+func (o *SearchProductsParams) SetQ(q string) {
+	o.Q = q
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SearchProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Currency != nil {
+
+		// query param Currency
+		var qrCurrency string
+
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+
+			if err := r.SetQueryParam("Currency", qCurrency); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param q
+	qrQ := o.Q
+	qQ := qrQ
+	if qQ != "" {
+
+		if err := r.SetQueryParam("q", qQ); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// SearchProductsReader is a Reader for the SearchProducts structure.
+type SearchProductsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SearchProductsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSearchProductsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewSearchProductsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /products/search] searchProducts", response, response.Code())
+	}
+}
+
+// NewSearchProductsOK creates a SearchProductsOK with default headers values
+func NewSearchProductsOK() *SearchProductsOK {
+	return &SearchProductsOK{}
+}
+
+/*
+SearchProductsOK describes a response with status code 200, with default header values.
+
+The products matching a search, best match first
+*/
+type SearchProductsOK struct {
+
+	/* Set to unavailable when the prices could not be converted and are in their stored currency
+	 */
+	XCurrencyConversion string
+
+	Payload []*models.Product
+}
+
+// IsSuccess returns true when this search products o k response has a 2xx status code
+func (o *SearchProductsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this search products o k response has a 3xx status code
+func (o *SearchProductsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search products o k response has a 4xx status code
+func (o *SearchProductsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this search products o k response has a 5xx status code
+func (o *SearchProductsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search products o k response a status code equal to that given
+func (o *SearchProductsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the search products o k response
+func (o *SearchProductsOK) Code() int {
+	return 200
+}
+
+func (o *SearchProductsOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/search][%d] searchProductsOK %s", 200, payload)
+}
+
+func (o *SearchProductsOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/search][%d] searchProductsOK %s", 200, payload)
+}
+
+func (o *SearchProductsOK) GetPayload() []*models.Product {
+	return o.Payload
+}
+
+func (o *SearchProductsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header X-Currency-Conversion
+	hdrXCurrencyConversion := response.GetHeader("X-Currency-Conversion")
+
+	if hdrXCurrencyConversion != "" {
+		o.XCurrencyConversion = hdrXCurrencyConversion
+	}
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSearchProductsBadRequest creates a SearchProductsBadRequest with default headers values
+func NewSearchProductsBadRequest() *SearchProductsBadRequest {
+	return &SearchProductsBadRequest{}
+}
+
+/*
+SearchProductsBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type SearchProductsBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this search products bad request response has a 2xx status code
+func (o *SearchProductsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this search products bad request response has a 3xx status code
+func (o *SearchProductsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this search products bad request response has a 4xx status code
+func (o *SearchProductsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this search products bad request response has a 5xx status code
+func (o *SearchProductsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this search products bad request response a status code equal to that given
+func (o *SearchProductsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the search products bad request response
+func (o *SearchProductsBadRequest) Code() int {
+	return 400
+}
+
+func (o *SearchProductsBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/search][%d] searchProductsBadRequest %s", 400, payload)
+}
+
+func (o *SearchProductsBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/search][%d] searchProductsBadRequest %s", 400, payload)
+}
+
+func (o *SearchProductsBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *SearchProductsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
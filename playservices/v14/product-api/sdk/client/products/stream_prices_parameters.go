@@ -0,0 +1,398 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewStreamPricesParams creates a new StreamPricesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewStreamPricesParams() *StreamPricesParams {
+	return &StreamPricesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewStreamPricesParamsWithTimeout creates a new StreamPricesParams object
+// with the ability to set a timeout on a request.
+func NewStreamPricesParamsWithTimeout(timeout time.Duration) *StreamPricesParams {
+	return &StreamPricesParams{
+		timeout: timeout,
+	}
+}
+
+// NewStreamPricesParamsWithContext creates a new StreamPricesParams object
+// with the ability to set a context for a request.
+func NewStreamPricesParamsWithContext(ctx context.Context) *StreamPricesParams {
+	return &StreamPricesParams{
+		Context: ctx,
+	}
+}
+
+// NewStreamPricesParamsWithHTTPClient creates a new StreamPricesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewStreamPricesParamsWithHTTPClient(client *http.Client) *StreamPricesParams {
+	return &StreamPricesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+StreamPricesParams contains all the parameters to send to the API endpoint
+
+	for the stream prices operation.
+
+	Typically these are written to a http.Request.
+*/
+type StreamPricesParams struct {
+
+	/* Currency.
+
+	   Currency the prices are streamed in
+	*/
+	Currency string
+
+	/* Cursor.
+
+	   Opaque cursor taken from the nextCursor of the previous page
+	*/
+	Cursor *string
+
+	/* Limit.
+
+	   Maximum number of products to return, defaults to 50 and is capped at 200
+
+	   Format: int64
+	*/
+	Limit *int64
+
+	/* MaxPrice.
+
+	   Only return products priced at less than or equal to this decimal amount of priceCurrency
+	*/
+	MaxPrice *string
+
+	/* MinPrice.
+
+	   Only return products priced at greater than or equal to this decimal amount of priceCurrency
+	*/
+	MinPrice *string
+
+	/* PriceCurrency.
+
+	     Only return the products priced in this ISO 4217 currency, it is the
+	currency of minPrice and maxPrice and defaults to EUR when they are set
+	*/
+	PriceCurrency *string
+
+	/* Sku.
+
+	   Only return the product with this SKU
+	*/
+	SKU *string
+
+	/* Sort.
+
+	   Sort order, one of price, -price, name or -name, sorting by price groups the products by the currency they are priced in
+	*/
+	Sort *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the stream prices params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamPricesParams) WithDefaults() *StreamPricesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the stream prices params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *StreamPricesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the stream prices params
+func (o *StreamPricesParams) WithTimeout(timeout time.Duration) *StreamPricesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the stream prices params
+func (o *StreamPricesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the stream prices params
+func (o *StreamPricesParams) WithContext(ctx context.Context) *StreamPricesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the stream prices params
+func (o *StreamPricesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the stream prices params
+func (o *StreamPricesParams) WithHTTPClient(client *http.Client) *StreamPricesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the stream prices params
+func (o *StreamPricesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the stream prices params
+func (o *StreamPricesParams) WithCurrency(currency string) *StreamPricesParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the stream prices params
+func (o *StreamPricesParams) SetCurrency(currency string) {
+	o.Currency = currency
+}
+
+// WithCursor adds the cursor to the stream prices params
+func (o *StreamPricesParams) WithCursor(cursor *string) *StreamPricesParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the stream prices params
+func (o *StreamPricesParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithLimit adds the limit to the stream prices params
+func (o *StreamPricesParams) WithLimit(limit *int64) *StreamPricesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the stream prices params
+func (o *StreamPricesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxPrice adds the maxPrice to the stream prices params
+func (o *StreamPricesParams) WithMaxPrice(maxPrice *string) *StreamPricesParams {
+	o.SetMaxPrice(maxPrice)
+	return o
+}
+
+// SetMaxPrice adds the maxPrice to the stream prices params
+func (o *StreamPricesParams) SetMaxPrice(maxPrice *string) {
+	o.MaxPrice = maxPrice
+}
+
+// WithMinPrice adds the minPrice to the stream prices params
+func (o *StreamPricesParams) WithMinPrice(minPrice *string) *StreamPricesParams {
+	o.SetMinPrice(minPrice)
+	return o
+}
+
+// SetMinPrice adds the minPrice to the stream prices params
+func (o *StreamPricesParams) SetMinPrice(minPrice *string) {
+	o.MinPrice = minPrice
+}
+
+// WithPriceCurrency adds the priceCurrency to the stream prices params
+func (o *StreamPricesParams) WithPriceCurrency(priceCurrency *string) *StreamPricesParams {
+	o.SetPriceCurrency(priceCurrency)
+	return o
+}
+
+// SetPriceCurrency adds the priceCurrency to the stream prices params
+func (o *StreamPricesParams) SetPriceCurrency(priceCurrency *string) {
+	o.PriceCurrency = priceCurrency
+}
+
+// WithSKU adds the sku to the stream prices params
+func (o *StreamPricesParams) WithSKU(sku *string) *StreamPricesParams {
+	o.SetSKU(sku)
+	return o
+}
+
+// SetSKU adds the sku to the stream prices params
+func (o *StreamPricesParams) SetSKU(sku *string) {
+	o.SKU = sku
+}
+
+// WithSort adds the sort to the stream prices params
+func (o *StreamPricesParams) WithSort(sort *string) *StreamPricesParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the stream prices params
+func (o *StreamPricesParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *StreamPricesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param currency
+	qrCurrency := o.Currency
+	qCurrency := qrCurrency
+	if qCurrency != "" {
+
+		if err := r.SetQueryParam("currency", qCurrency); err != nil {
+			return err
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MaxPrice != nil {
+
+		// query param maxPrice
+		var qrMaxPrice string
+
+		if o.MaxPrice != nil {
+			qrMaxPrice = *o.MaxPrice
+		}
+		qMaxPrice := qrMaxPrice
+		if qMaxPrice != "" {
+
+			if err := r.SetQueryParam("maxPrice", qMaxPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.MinPrice != nil {
+
+		// query param minPrice
+		var qrMinPrice string
+
+		if o.MinPrice != nil {
+			qrMinPrice = *o.MinPrice
+		}
+		qMinPrice := qrMinPrice
+		if qMinPrice != "" {
+
+			if err := r.SetQueryParam("minPrice", qMinPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PriceCurrency != nil {
+
+		// query param priceCurrency
+		var qrPriceCurrency string
+
+		if o.PriceCurrency != nil {
+			qrPriceCurrency = *o.PriceCurrency
+		}
+		qPriceCurrency := qrPriceCurrency
+		if qPriceCurrency != "" {
+
+			if err := r.SetQueryParam("priceCurrency", qPriceCurrency); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.SKU != nil {
+
+		// query param sku
+		var qrSku string
+
+		if o.SKU != nil {
+			qrSku = *o.SKU
+		}
+		qSku := qrSku
+		if qSku != "" {
+
+			if err := r.SetQueryParam("sku", qSku); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
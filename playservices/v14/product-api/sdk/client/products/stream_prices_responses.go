@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// StreamPricesReader is a Reader for the StreamPrices structure.
+type StreamPricesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamPricesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewStreamPricesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewStreamPricesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewStreamPricesServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /products/stream] streamPrices", response, response.Code())
+	}
+}
+
+// NewStreamPricesOK creates a StreamPricesOK with default headers values
+func NewStreamPricesOK() *StreamPricesOK {
+	return &StreamPricesOK{}
+}
+
+/*
+	StreamPricesOK describes a response with status code 200, with default header values.
+
+	A stream of price events, as Server-Sent Events with the prices event
+
+name or as WebSocket JSON messages
+*/
+type StreamPricesOK struct {
+	Payload *models.PriceEvent
+}
+
+// IsSuccess returns true when this stream prices o k response has a 2xx status code
+func (o *StreamPricesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this stream prices o k response has a 3xx status code
+func (o *StreamPricesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices o k response has a 4xx status code
+func (o *StreamPricesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream prices o k response has a 5xx status code
+func (o *StreamPricesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream prices o k response a status code equal to that given
+func (o *StreamPricesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the stream prices o k response
+func (o *StreamPricesOK) Code() int {
+	return 200
+}
+
+func (o *StreamPricesOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesOK %s", 200, payload)
+}
+
+func (o *StreamPricesOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesOK %s", 200, payload)
+}
+
+func (o *StreamPricesOK) GetPayload() *models.PriceEvent {
+	return o.Payload
+}
+
+func (o *StreamPricesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PriceEvent)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamPricesBadRequest creates a StreamPricesBadRequest with default headers values
+func NewStreamPricesBadRequest() *StreamPricesBadRequest {
+	return &StreamPricesBadRequest{}
+}
+
+/*
+StreamPricesBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type StreamPricesBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this stream prices bad request response has a 2xx status code
+func (o *StreamPricesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream prices bad request response has a 3xx status code
+func (o *StreamPricesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices bad request response has a 4xx status code
+func (o *StreamPricesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream prices bad request response has a 5xx status code
+func (o *StreamPricesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream prices bad request response a status code equal to that given
+func (o *StreamPricesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the stream prices bad request response
+func (o *StreamPricesBadRequest) Code() int {
+	return 400
+}
+
+func (o *StreamPricesBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesBadRequest %s", 400, payload)
+}
+
+func (o *StreamPricesBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesBadRequest %s", 400, payload)
+}
+
+func (o *StreamPricesBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *StreamPricesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamPricesServiceUnavailable creates a StreamPricesServiceUnavailable with default headers values
+func NewStreamPricesServiceUnavailable() *StreamPricesServiceUnavailable {
+	return &StreamPricesServiceUnavailable{}
+}
+
+/*
+StreamPricesServiceUnavailable describes a response with status code 503, with default header values.
+
+Generic error message returned as a string
+*/
+type StreamPricesServiceUnavailable struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this stream prices service unavailable response has a 2xx status code
+func (o *StreamPricesServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream prices service unavailable response has a 3xx status code
+func (o *StreamPricesServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices service unavailable response has a 4xx status code
+func (o *StreamPricesServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream prices service unavailable response has a 5xx status code
+func (o *StreamPricesServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this stream prices service unavailable response a status code equal to that given
+func (o *StreamPricesServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the stream prices service unavailable response
+func (o *StreamPricesServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *StreamPricesServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesServiceUnavailable %s", 503, payload)
+}
+
+func (o *StreamPricesServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream][%d] streamPricesServiceUnavailable %s", 503, payload)
+}
+
+func (o *StreamPricesServiceUnavailable) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *StreamPricesServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/AmitSuresh/playground/playservices/v14/product-api/sdk/models"
+)
+
+// StreamPricesWebSocketReader is a Reader for the StreamPricesWebSocket structure.
+type StreamPricesWebSocketReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StreamPricesWebSocketReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 101:
+		result := NewStreamPricesWebSocketSwitchingProtocols()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewStreamPricesWebSocketBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 503:
+		result := NewStreamPricesWebSocketServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /products/stream/ws] streamPricesWebSocket", response, response.Code())
+	}
+}
+
+// NewStreamPricesWebSocketSwitchingProtocols creates a StreamPricesWebSocketSwitchingProtocols with default headers values
+func NewStreamPricesWebSocketSwitchingProtocols() *StreamPricesWebSocketSwitchingProtocols {
+	return &StreamPricesWebSocketSwitchingProtocols{}
+}
+
+/*
+	StreamPricesWebSocketSwitchingProtocols describes a response with status code 101, with default header values.
+
+	A stream of price events, as Server-Sent Events with the prices event
+
+name or as WebSocket JSON messages
+*/
+type StreamPricesWebSocketSwitchingProtocols struct {
+	Payload *models.PriceEvent
+}
+
+// IsSuccess returns true when this stream prices web socket switching protocols response has a 2xx status code
+func (o *StreamPricesWebSocketSwitchingProtocols) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream prices web socket switching protocols response has a 3xx status code
+func (o *StreamPricesWebSocketSwitchingProtocols) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices web socket switching protocols response has a 4xx status code
+func (o *StreamPricesWebSocketSwitchingProtocols) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream prices web socket switching protocols response has a 5xx status code
+func (o *StreamPricesWebSocketSwitchingProtocols) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream prices web socket switching protocols response a status code equal to that given
+func (o *StreamPricesWebSocketSwitchingProtocols) IsCode(code int) bool {
+	return code == 101
+}
+
+// Code gets the status code for the stream prices web socket switching protocols response
+func (o *StreamPricesWebSocketSwitchingProtocols) Code() int {
+	return 101
+}
+
+func (o *StreamPricesWebSocketSwitchingProtocols) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketSwitchingProtocols %s", 101, payload)
+}
+
+func (o *StreamPricesWebSocketSwitchingProtocols) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketSwitchingProtocols %s", 101, payload)
+}
+
+func (o *StreamPricesWebSocketSwitchingProtocols) GetPayload() *models.PriceEvent {
+	return o.Payload
+}
+
+func (o *StreamPricesWebSocketSwitchingProtocols) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PriceEvent)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamPricesWebSocketBadRequest creates a StreamPricesWebSocketBadRequest with default headers values
+func NewStreamPricesWebSocketBadRequest() *StreamPricesWebSocketBadRequest {
+	return &StreamPricesWebSocketBadRequest{}
+}
+
+/*
+StreamPricesWebSocketBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type StreamPricesWebSocketBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this stream prices web socket bad request response has a 2xx status code
+func (o *StreamPricesWebSocketBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream prices web socket bad request response has a 3xx status code
+func (o *StreamPricesWebSocketBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices web socket bad request response has a 4xx status code
+func (o *StreamPricesWebSocketBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stream prices web socket bad request response has a 5xx status code
+func (o *StreamPricesWebSocketBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stream prices web socket bad request response a status code equal to that given
+func (o *StreamPricesWebSocketBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the stream prices web socket bad request response
+func (o *StreamPricesWebSocketBadRequest) Code() int {
+	return 400
+}
+
+func (o *StreamPricesWebSocketBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketBadRequest %s", 400, payload)
+}
+
+func (o *StreamPricesWebSocketBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketBadRequest %s", 400, payload)
+}
+
+func (o *StreamPricesWebSocketBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *StreamPricesWebSocketBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStreamPricesWebSocketServiceUnavailable creates a StreamPricesWebSocketServiceUnavailable with default headers values
+func NewStreamPricesWebSocketServiceUnavailable() *StreamPricesWebSocketServiceUnavailable {
+	return &StreamPricesWebSocketServiceUnavailable{}
+}
+
+/*
+StreamPricesWebSocketServiceUnavailable describes a response with status code 503, with default header values.
+
+Generic error message returned as a string
+*/
+type StreamPricesWebSocketServiceUnavailable struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this stream prices web socket service unavailable response has a 2xx status code
+func (o *StreamPricesWebSocketServiceUnavailable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stream prices web socket service unavailable response has a 3xx status code
+func (o *StreamPricesWebSocketServiceUnavailable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stream prices web socket service unavailable response has a 4xx status code
+func (o *StreamPricesWebSocketServiceUnavailable) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this stream prices web socket service unavailable response has a 5xx status code
+func (o *StreamPricesWebSocketServiceUnavailable) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this stream prices web socket service unavailable response a status code equal to that given
+func (o *StreamPricesWebSocketServiceUnavailable) IsCode(code int) bool {
+	return code == 503
+}
+
+// Code gets the status code for the stream prices web socket service unavailable response
+func (o *StreamPricesWebSocketServiceUnavailable) Code() int {
+	return 503
+}
+
+func (o *StreamPricesWebSocketServiceUnavailable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketServiceUnavailable %s", 503, payload)
+}
+
+func (o *StreamPricesWebSocketServiceUnavailable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /products/stream/ws][%d] streamPricesWebSocketServiceUnavailable %s", 503, payload)
+}
+
+func (o *StreamPricesWebSocketServiceUnavailable) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *StreamPricesWebSocketServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
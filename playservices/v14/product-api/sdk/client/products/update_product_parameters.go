@@ -70,6 +70,13 @@ type UpdateProductParams struct {
 	*/
 	Body *models.Product
 
+	/* IfMatch.
+
+	     ETag returned by GET /products/{id}, the update is rejected
+	with 412 when the product has changed since
+	*/
+	IfMatch string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -134,6 +141,17 @@ func (o *UpdateProductParams) SetBody(body *models.Product) {
 	o.Body = body
 }
 
+// WithIfMatch adds the ifMatch to the update product params
+func (o *UpdateProductParams) WithIfMatch(ifMatch string) *UpdateProductParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update product params
+func (o *UpdateProductParams) SetIfMatch(ifMatch string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *UpdateProductParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -147,6 +165,11 @@ func (o *UpdateProductParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	// header param If-Match
+	if err := r.SetHeaderParam("If-Match", o.IfMatch); err != nil {
+		return err
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
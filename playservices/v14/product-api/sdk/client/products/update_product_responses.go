@@ -30,18 +30,48 @@ func (o *UpdateProductReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewUpdateProductBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewUpdateProductNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewUpdateProductConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewUpdateProductPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 413:
+		result := NewUpdateProductRequestEntityTooLarge()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewUpdateProductUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 428:
+		result := NewUpdateProductPreconditionRequired()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("[PUT /products] updateProduct", response, response.Code())
 	}
@@ -103,6 +133,76 @@ func (o *UpdateProductCreated) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewUpdateProductBadRequest creates a UpdateProductBadRequest with default headers values
+func NewUpdateProductBadRequest() *UpdateProductBadRequest {
+	return &UpdateProductBadRequest{}
+}
+
+/*
+UpdateProductBadRequest describes a response with status code 400, with default header values.
+
+Generic error message returned as a string
+*/
+type UpdateProductBadRequest struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this update product bad request response has a 2xx status code
+func (o *UpdateProductBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update product bad request response has a 3xx status code
+func (o *UpdateProductBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update product bad request response has a 4xx status code
+func (o *UpdateProductBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update product bad request response has a 5xx status code
+func (o *UpdateProductBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update product bad request response a status code equal to that given
+func (o *UpdateProductBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the update product bad request response
+func (o *UpdateProductBadRequest) Code() int {
+	return 400
+}
+
+func (o *UpdateProductBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductBadRequest %s", 400, payload)
+}
+
+func (o *UpdateProductBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductBadRequest %s", 400, payload)
+}
+
+func (o *UpdateProductBadRequest) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *UpdateProductBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateProductNotFound creates a UpdateProductNotFound with default headers values
 func NewUpdateProductNotFound() *UpdateProductNotFound {
 	return &UpdateProductNotFound{}
@@ -173,6 +273,216 @@ func (o *UpdateProductNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewUpdateProductConflict creates a UpdateProductConflict with default headers values
+func NewUpdateProductConflict() *UpdateProductConflict {
+	return &UpdateProductConflict{}
+}
+
+/*
+UpdateProductConflict describes a response with status code 409, with default header values.
+
+The SKU of a product is already used by another product
+*/
+type UpdateProductConflict struct {
+	Payload *models.ConflictError
+}
+
+// IsSuccess returns true when this update product conflict response has a 2xx status code
+func (o *UpdateProductConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update product conflict response has a 3xx status code
+func (o *UpdateProductConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update product conflict response has a 4xx status code
+func (o *UpdateProductConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update product conflict response has a 5xx status code
+func (o *UpdateProductConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update product conflict response a status code equal to that given
+func (o *UpdateProductConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the update product conflict response
+func (o *UpdateProductConflict) Code() int {
+	return 409
+}
+
+func (o *UpdateProductConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductConflict %s", 409, payload)
+}
+
+func (o *UpdateProductConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductConflict %s", 409, payload)
+}
+
+func (o *UpdateProductConflict) GetPayload() *models.ConflictError {
+	return o.Payload
+}
+
+func (o *UpdateProductConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ConflictError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateProductPreconditionFailed creates a UpdateProductPreconditionFailed with default headers values
+func NewUpdateProductPreconditionFailed() *UpdateProductPreconditionFailed {
+	return &UpdateProductPreconditionFailed{}
+}
+
+/*
+UpdateProductPreconditionFailed describes a response with status code 412, with default header values.
+
+Generic error message returned as a string
+*/
+type UpdateProductPreconditionFailed struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this update product precondition failed response has a 2xx status code
+func (o *UpdateProductPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update product precondition failed response has a 3xx status code
+func (o *UpdateProductPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update product precondition failed response has a 4xx status code
+func (o *UpdateProductPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update product precondition failed response has a 5xx status code
+func (o *UpdateProductPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update product precondition failed response a status code equal to that given
+func (o *UpdateProductPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the update product precondition failed response
+func (o *UpdateProductPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *UpdateProductPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductPreconditionFailed %s", 412, payload)
+}
+
+func (o *UpdateProductPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductPreconditionFailed %s", 412, payload)
+}
+
+func (o *UpdateProductPreconditionFailed) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *UpdateProductPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateProductRequestEntityTooLarge creates a UpdateProductRequestEntityTooLarge with default headers values
+func NewUpdateProductRequestEntityTooLarge() *UpdateProductRequestEntityTooLarge {
+	return &UpdateProductRequestEntityTooLarge{}
+}
+
+/*
+UpdateProductRequestEntityTooLarge describes a response with status code 413, with default header values.
+
+Generic error message returned as a string
+*/
+type UpdateProductRequestEntityTooLarge struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this update product request entity too large response has a 2xx status code
+func (o *UpdateProductRequestEntityTooLarge) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update product request entity too large response has a 3xx status code
+func (o *UpdateProductRequestEntityTooLarge) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update product request entity too large response has a 4xx status code
+func (o *UpdateProductRequestEntityTooLarge) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update product request entity too large response has a 5xx status code
+func (o *UpdateProductRequestEntityTooLarge) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update product request entity too large response a status code equal to that given
+func (o *UpdateProductRequestEntityTooLarge) IsCode(code int) bool {
+	return code == 413
+}
+
+// Code gets the status code for the update product request entity too large response
+func (o *UpdateProductRequestEntityTooLarge) Code() int {
+	return 413
+}
+
+func (o *UpdateProductRequestEntityTooLarge) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *UpdateProductRequestEntityTooLarge) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductRequestEntityTooLarge %s", 413, payload)
+}
+
+func (o *UpdateProductRequestEntityTooLarge) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *UpdateProductRequestEntityTooLarge) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateProductUnprocessableEntity creates a UpdateProductUnprocessableEntity with default headers values
 func NewUpdateProductUnprocessableEntity() *UpdateProductUnprocessableEntity {
 	return &UpdateProductUnprocessableEntity{}
@@ -242,3 +552,73 @@ func (o *UpdateProductUnprocessableEntity) readResponse(response runtime.ClientR
 
 	return nil
 }
+
+// NewUpdateProductPreconditionRequired creates a UpdateProductPreconditionRequired with default headers values
+func NewUpdateProductPreconditionRequired() *UpdateProductPreconditionRequired {
+	return &UpdateProductPreconditionRequired{}
+}
+
+/*
+UpdateProductPreconditionRequired describes a response with status code 428, with default header values.
+
+Generic error message returned as a string
+*/
+type UpdateProductPreconditionRequired struct {
+	Payload *models.GenericError
+}
+
+// IsSuccess returns true when this update product precondition required response has a 2xx status code
+func (o *UpdateProductPreconditionRequired) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update product precondition required response has a 3xx status code
+func (o *UpdateProductPreconditionRequired) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update product precondition required response has a 4xx status code
+func (o *UpdateProductPreconditionRequired) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update product precondition required response has a 5xx status code
+func (o *UpdateProductPreconditionRequired) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update product precondition required response a status code equal to that given
+func (o *UpdateProductPreconditionRequired) IsCode(code int) bool {
+	return code == 428
+}
+
+// Code gets the status code for the update product precondition required response
+func (o *UpdateProductPreconditionRequired) Code() int {
+	return 428
+}
+
+func (o *UpdateProductPreconditionRequired) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductPreconditionRequired %s", 428, payload)
+}
+
+func (o *UpdateProductPreconditionRequired) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /products][%d] updateProductPreconditionRequired %s", 428, payload)
+}
+
+func (o *UpdateProductPreconditionRequired) GetPayload() *models.GenericError {
+	return o.Payload
+}
+
+func (o *UpdateProductPreconditionRequired) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GenericError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConflictError ConflictError is returned when products could not be written because
+// their SKU is already used by another product
+//
+// swagger:model ConflictError
+type ConflictError struct {
+
+	// conflicts
+	Conflicts []*SKUConflict `json:"conflicts"`
+
+	// InsertedIDs are the ids of the products of a bulk create which did not conflict
+	InsertedIDs []string `json:"insertedIds"`
+
+	// message
+	Message string `json:"message,omitempty"`
+}
+
+// Validate validates this conflict error
+func (m *ConflictError) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConflicts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConflictError) validateConflicts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Conflicts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Conflicts); i++ {
+		if swag.IsZero(m.Conflicts[i]) { // not required
+			continue
+		}
+
+		if m.Conflicts[i] != nil {
+			if err := m.Conflicts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("conflicts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("conflicts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this conflict error based on the context it is used
+func (m *ConflictError) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConflicts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConflictError) contextValidateConflicts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Conflicts); i++ {
+
+		if m.Conflicts[i] != nil {
+
+			if swag.IsZero(m.Conflicts[i]) { // not required
+				return nil
+			}
+
+			if err := m.Conflicts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("conflicts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("conflicts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConflictError) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConflictError) UnmarshalBinary(b []byte) error {
+	var res ConflictError
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
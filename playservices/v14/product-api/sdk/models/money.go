@@ -0,0 +1,92 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Money Money is an exact amount of a currency, the amount is a decimal string
+// so that it is not parsed into a float
+//
+// swagger:model Money
+type Money struct {
+
+	// the decimal amount, with at most the minor unit decimals of the currency
+	// Example: 2.45
+	// Required: true
+	Amount *string `json:"amount"`
+
+	// the ISO 4217 currency code, one of the currencies known to the currency service.
+	// For a product price this is the base currency the product is priced and converted from
+	// Example: EUR
+	// Required: true
+	Currency *string `json:"currency"`
+}
+
+// Validate validates this money
+func (m *Money) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Money) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Money) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this money based on context it is used
+func (m *Money) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Money) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Money) UnmarshalBinary(b []byte) error {
+	var res Money
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PriceEvent PriceEvent is pushed on a price stream whenever the prices change
+//
+// swagger:model PriceEvent
+type PriceEvent struct {
+
+	// Converted is false when the prices could not be converted and are in
+	// their stored currency
+	Converted bool `json:"converted,omitempty"`
+
+	// products
+	Products *ProductPage `json:"products,omitempty"`
+
+	// rate
+	Rate *RateUpdate `json:"rate,omitempty"`
+}
+
+// Validate validates this price event
+func (m *PriceEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProducts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PriceEvent) validateProducts(formats strfmt.Registry) error {
+	if swag.IsZero(m.Products) { // not required
+		return nil
+	}
+
+	if m.Products != nil {
+		if err := m.Products.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("products")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("products")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PriceEvent) validateRate(formats strfmt.Registry) error {
+	if swag.IsZero(m.Rate) { // not required
+		return nil
+	}
+
+	if m.Rate != nil {
+		if err := m.Rate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this price event based on the context it is used
+func (m *PriceEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateProducts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PriceEvent) contextValidateProducts(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Products != nil {
+
+		if swag.IsZero(m.Products) { // not required
+			return nil
+		}
+
+		if err := m.Products.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("products")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("products")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PriceEvent) contextValidateRate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Rate != nil {
+
+		if swag.IsZero(m.Rate) { // not required
+			return nil
+		}
+
+		if err := m.Rate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("rate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PriceEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PriceEvent) UnmarshalBinary(b []byte) error {
+	var res PriceEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,16 @@ import (
 // swagger:model Product
 type Product struct {
 
+	// when the product was moved to the trash, deleted products are
+	// hidden from every read except the trash listing
+	// Read Only: true
+	// Format: date-time
+	DeletedAt strfmt.DateTime `json:"deletedAt,omitempty"`
+
+	// who moved the product to the trash
+	// Read Only: true
+	DeletedBy string `json:"deletedBy,omitempty"`
+
 	// the description for this poduct
 	// Max Length: 10000
 	Description string `json:"description,omitempty"`
@@ -32,21 +42,34 @@ type Product struct {
 	// Max Length: 255
 	Name *string `json:"name"`
 
-	// the price for the product
-	// Required: true
-	// Minimum: 0.01
-	Price *float32 `json:"price"`
+	// the price converted into each currency asked for with the currencies
+	// parameter, keyed by ISO 4217 code
+	// Read Only: true
+	Prices map[string]Money `json:"prices,omitempty"`
 
 	// the SKU for the product
 	// Required: true
 	// Pattern: [a-z]+-[a-z]+-[a-z]+
 	SKU *string `json:"sku"`
+
+	// the version of the product, it is incremented on every update
+	// and must be sent back in the If-Match header of a PUT
+	// Read Only: true
+	Version int64 `json:"version,omitempty"`
+
+	// price
+	// Required: true
+	Price *Money `json:"price"`
 }
 
 // Validate validates this product
 func (m *Product) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -59,7 +82,7 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validatePrice(formats); err != nil {
+	if err := m.validatePrices(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -67,12 +90,28 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePrice(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Product) validateDeletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deletedAt", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Product) validateDescription(formats strfmt.Registry) error {
 	if swag.IsZero(m.Description) { // not required
 		return nil
@@ -110,34 +149,151 @@ func (m *Product) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Product) validatePrices(formats strfmt.Registry) error {
+	if swag.IsZero(m.Prices) { // not required
+		return nil
+	}
+
+	for k := range m.Prices {
+
+		if err := validate.Required("prices"+"."+k, "body", m.Prices[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Prices[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prices" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prices" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) validateSKU(formats strfmt.Registry) error {
+
+	if err := validate.Required("sku", "body", m.SKU); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("sku", "body", *m.SKU, `[a-z]+-[a-z]+-[a-z]+`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Product) validatePrice(formats strfmt.Registry) error {
 
 	if err := validate.Required("price", "body", m.Price); err != nil {
 		return err
 	}
 
-	if err := validate.Minimum("price", "body", float64(*m.Price), 0.01, false); err != nil {
+	if m.Price != nil {
+		if err := m.Price.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("price")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("price")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this product based on the context it is used
+func (m *Product) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDeletedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDeletedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrices(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersion(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrice(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Product) contextValidateDeletedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "deletedAt", "body", strfmt.DateTime(m.DeletedAt)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (m *Product) validateSKU(formats strfmt.Registry) error {
+func (m *Product) contextValidateDeletedBy(ctx context.Context, formats strfmt.Registry) error {
 
-	if err := validate.Required("sku", "body", m.SKU); err != nil {
+	if err := validate.ReadOnly(ctx, "deletedBy", "body", string(m.DeletedBy)); err != nil {
 		return err
 	}
 
-	if err := validate.Pattern("sku", "body", *m.SKU, `[a-z]+-[a-z]+-[a-z]+`); err != nil {
+	return nil
+}
+
+func (m *Product) contextValidatePrices(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.Prices {
+
+		if val, ok := m.Prices[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateVersion(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "version", "body", int64(m.Version)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this product based on context it is used
-func (m *Product) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+func (m *Product) contextValidatePrice(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Price != nil {
+
+		if err := m.Price.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("price")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("price")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RateUpdate RateUpdate is a rate pushed by the currency service
+//
+// swagger:model RateUpdate
+type RateUpdate struct {
+
+	// base
+	Base string `json:"base,omitempty"`
+
+	// destination
+	Destination string `json:"destination,omitempty"`
+
+	// rate
+	Rate float64 `json:"rate,omitempty"`
+}
+
+// Validate validates this rate update
+func (m *RateUpdate) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this rate update based on context it is used
+func (m *RateUpdate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RateUpdate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RateUpdate) UnmarshalBinary(b []byte) error {
+	var res RateUpdate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,54 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RowError RowError is a problem with a single row of an import, the row number
+// is 1 based and does not count the CSV header
+//
+// swagger:model RowError
+type RowError struct {
+
+	// messages
+	Messages []string `json:"messages"`
+
+	// row
+	Row int64 `json:"row,omitempty"`
+}
+
+// Validate validates this row error
+func (m *RowError) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this row error based on context it is used
+func (m *RowError) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RowError) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RowError) UnmarshalBinary(b []byte) error {
+	var res RowError
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
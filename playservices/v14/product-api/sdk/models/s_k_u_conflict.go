@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SKUConflict SKUConflict describes a product which could not be written because
+// another product already has its SKU
+//
+// swagger:model SKUConflict
+type SKUConflict struct {
+
+	// ExistingID is the id of the product which already has the SKU
+	ExistingID string `json:"existingId,omitempty"`
+
+	// Item is the position of the product in the slice given to AddProduct,
+	// it is always 0 for an update
+	Item int64 `json:"item,omitempty"`
+
+	// SKU is the duplicated SKU
+	SKU string `json:"sku,omitempty"`
+}
+
+// Validate validates this s k u conflict
+func (m *SKUConflict) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this s k u conflict based on context it is used
+func (m *SKUConflict) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SKUConflict) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SKUConflict) UnmarshalBinary(b []byte) error {
+	var res SKUConflict
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}